@@ -0,0 +1,95 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+)
+
+// interpretAddressStructure interprets the ADDRESS_STRUCTURE subordinate to the first of the given lines,
+// i.e. ADDR along with its address parts and the PHON, EMAIL, FAX and WWW lines at the same level as ADDR.
+// It returns nil if the structure doesn't have any address or contact information.
+func interpretAddressStructure(parentLines []*Line) *Gedcom_Address {
+	address := &Gedcom_Address{}
+	found := false
+	forEachSubordinateLine(parentLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "ADDR":
+			found = true
+			interpretAddressLines(subordinateLines, address)
+		case "PHON":
+			found = true
+			address.PhoneNumbers = append(address.PhoneNumbers, subordinateLines[0].Value())
+		case "EMAIL":
+			found = true
+			address.EmailAddresses = append(address.EmailAddresses, subordinateLines[0].Value())
+		case "FAX":
+			found = true
+			address.FaxNumbers = append(address.FaxNumbers, subordinateLines[0].Value())
+		case "WWW":
+			found = true
+			address.WebPages = append(address.WebPages, subordinateLines[0].Value())
+		}
+	})
+	if !found {
+		return nil
+	}
+	return address
+}
+
+func interpretAddressLines(addressLines []*Line, address *Gedcom_Address) {
+	address.Text = interpretTextStructure(addressLines)
+	forEachSubordinateLine(addressLines, func(tag string, subordinateLines []*Line) {
+		value := subordinateLines[0].Value()
+		switch tag {
+		case "ADR1":
+			address.AddressLine1 = value
+		case "ADR2":
+			address.AddressLine2 = value
+		case "ADR3":
+			address.AddressLine3 = value
+		case "CITY":
+			address.City = value
+		case "STAE":
+			address.State = value
+		case "POST":
+			address.PostalCode = value
+		case "CTRY":
+			address.Country = value
+		}
+	})
+}
+
+// hasAddressLines reports whether an address has any information to be written on an ADDR line or its subordinates
+func hasAddressLines(address *Gedcom_Address) bool {
+	return address.Text != "" || address.AddressLine1 != "" || address.AddressLine2 != "" || address.AddressLine3 != "" ||
+		address.City != "" || address.State != "" || address.PostalCode != "" || address.Country != ""
+}
+
+// createAndWriteAddressLines writes an ADDRESS_STRUCTURE at the given level, nil addresses are skipped
+func createAndWriteAddressLines(address *Gedcom_Address, addressLevel int, lineCounter *int, buf *bytes.Buffer) {
+	if address == nil {
+		return
+	}
+
+	if hasAddressLines(address) {
+		err := createAndWriteTextLines(addressLevel, "", "ADDR", address.Text, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else {
+			createAndWriteTagValueLines(addressLevel+1, []tagValue{
+				{"ADR1", address.AddressLine1},
+				{"ADR2", address.AddressLine2},
+				{"ADR3", address.AddressLine3},
+				{"CITY", address.City},
+				{"STAE", address.State},
+				{"POST", address.PostalCode},
+				{"CTRY", address.Country},
+			}, lineCounter, buf)
+		}
+	}
+
+	createAndWriteValueLines(addressLevel, "PHON", address.PhoneNumbers, lineCounter, buf)
+	createAndWriteValueLines(addressLevel, "EMAIL", address.EmailAddresses, lineCounter, buf)
+	createAndWriteValueLines(addressLevel, "FAX", address.FaxNumbers, lineCounter, buf)
+	createAndWriteValueLines(addressLevel, "WWW", address.WebPages, lineCounter, buf)
+}
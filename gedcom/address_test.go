@@ -0,0 +1,83 @@
+package gedcom
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+var addressLines = [][]string{
+	{
+		"2 CORP The Generations Network",
+		"3 ADDR 360 W 4800 N",
+		"4 CONT Provo, UT 84604",
+		"3 PHON (801) 705-7000",
+	},
+	{
+		"0 @R1@ REPO",
+		"1 NAME Family History Library",
+		"1 ADDR 35 N West Temple Street",
+		"2 ADR1 35 N West Temple Street",
+		"2 CITY Salt Lake City",
+		"2 STAE UT",
+		"2 POST 84150",
+		"2 CTRY USA",
+		"1 PHON +1 801 240 6996",
+		"1 PHON +1 866 406 1830",
+		"1 EMAIL fhl@example.org",
+		"1 FAX +1 801 240 1234",
+		"1 WWW https://www.familysearch.org",
+	},
+	{
+		"1 BIRT",
+		"2 DATE 1822",
+		"2 PLAC Salt Lake City",
+	},
+}
+
+var expectedAddresses = []*Gedcom_Address{
+	{
+		Text:         "360 W 4800 N\nProvo, UT 84604",
+		PhoneNumbers: []string{"(801) 705-7000"},
+	},
+	{
+		Text:           "35 N West Temple Street",
+		AddressLine1:   "35 N West Temple Street",
+		City:           "Salt Lake City",
+		State:          "UT",
+		PostalCode:     "84150",
+		Country:        "USA",
+		PhoneNumbers:   []string{"+1 801 240 6996", "+1 866 406 1830"},
+		EmailAddresses: []string{"fhl@example.org"},
+		FaxNumbers:     []string{"+1 801 240 1234"},
+		WebPages:       []string{"https://www.familysearch.org"},
+	},
+	nil,
+}
+
+func TestAddressStructure(t *testing.T) {
+	for i := range addressLines {
+		result := interpretAddressStructure(recordLines(addressLines[i]))
+		if !proto.Equal(result, expectedAddresses[i]) {
+			t.Errorf("result address does not equal expected; result: %+v, expected %+v", result, expectedAddresses[i])
+		}
+	}
+}
+
+func TestSerializeAddressStructure(t *testing.T) {
+	for _, address := range expectedAddresses {
+		buf := bytes.NewBuffer([]byte{})
+		lineCounter := 0
+		err := createAndWriteLine(0, "@R1@", "REPO", "", &lineCounter, buf)
+		if err != nil {
+			t.Fatalf("failed to write line with error: %s", err)
+		}
+		createAndWriteAddressLines(address, 1, &lineCounter, buf)
+
+		result := interpretAddressStructure(recordLines(splitLines(buf.String())))
+		if !proto.Equal(result, address) {
+			t.Errorf("serialized address does not equal original; result: %+v, expected %+v", result, address)
+		}
+	}
+}
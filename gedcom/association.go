@@ -0,0 +1,58 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+)
+
+// interpretAssociationStructure interprets an ASSOCIATION_STRUCTURE linking an individual to an associated individual,
+// e.g. a godparent or a witness, along with a description of their relation
+func interpretAssociationStructure(associationLines []*Line) *Gedcom_Individual_Association {
+	association := &Gedcom_Individual_Association{
+		IndividualId: associationLines[0].Value(),
+	}
+	forEachSubordinateLine(associationLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "RELA":
+			association.Relation = subordinateLines[0].Value()
+		case "ROLE":
+			association.Relation = interpretRoleStructure(subordinateLines)
+		case "SOUR":
+			association.SourceCitations = append(association.SourceCitations, interpretSourceCitationStructure(subordinateLines))
+		case "NOTE", "SNOTE":
+			association.Notes = append(association.Notes, interpretNoteLinkStructure(subordinateLines))
+		}
+	})
+	return association
+}
+
+// interpretRoleStructure interprets the GEDCOM 7.0 ROLE of an association,
+// preferring its PHRASE over the enumerated role as the description of the relation
+func interpretRoleStructure(roleLines []*Line) string {
+	relation := roleLines[0].Value()
+	forEachSubordinateLine(roleLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "PHRASE":
+			relation = subordinateLines[0].Value()
+		}
+	})
+	return relation
+}
+
+func createAndWriteAssociationLines(associations []*Gedcom_Individual_Association, associationLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, association := range associations {
+		err := createAndWriteLine(associationLevel, "", "ASSO", association.IndividualId, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if association.Relation != "" {
+			err := createAndWriteLine(associationLevel+1, "", "RELA", association.Relation, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+		createAndWriteSourceCitationLines(association.SourceCitations, associationLevel+1, lineCounter, buf)
+		createAndWriteNoteLinkLines(association.Notes, associationLevel+1, lineCounter, buf)
+	}
+}
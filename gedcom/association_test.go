@@ -0,0 +1,64 @@
+package gedcom
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+var associationLines = []string{
+	"0 HEAD",
+	"0 @I1@ INDI",
+	"1 NAME Harry /Potter/",
+	"1 ALIA @I3@",
+	"1 ALIA The Boy Who Lived",
+	"1 ASSO @I2@",
+	"2 RELA Godfather",
+	"2 SOUR @S1@",
+	"3 PAGE Chapter 10",
+	"3 QUAY 3",
+	"2 NOTE Named in ",
+	"3 CONC the will of his parents",
+	"2 NOTE @N1@",
+	"0 @I2@ INDI",
+	"1 NAME Sirius /Black/",
+	"0 @I3@ INDI",
+	"1 NAME Harry James /Potter/",
+	"0 TRLR",
+}
+
+var expectedAssociation = &Gedcom_Individual_Association{
+	IndividualId: "@I2@",
+	Relation:     "Godfather",
+	SourceCitations: []*Gedcom_SourceCitation{
+		{SourceId: "@S1@", Page: "Chapter 10", Quality: "3"},
+	},
+	Notes: []*Gedcom_NoteLink{
+		{SubmitterText: "Named in the will of his parents"},
+		{NoteId: "@N1@"},
+	},
+}
+
+func TestAssociationStructure(t *testing.T) {
+	g := interpretGedcomLines(associationLines)
+	assertAssociationsAndAliases(t, g)
+
+	buf, err := g.ToSerializedGedcom(nil)
+	if err != nil {
+		t.Fatalf("failed to serialize gedcom with error: %s", err)
+	}
+	assertAssociationsAndAliases(t, interpretGedcomLines(splitLines(buf.String())))
+}
+
+func assertAssociationsAndAliases(t *testing.T, g *ConcurrencySafeGedcom) {
+	harry := g.IndividualsByIds()["@I1@"]
+	if harry == nil {
+		t.Fatalf("expected individual @I1@ to be interpreted")
+	}
+	if len(harry.Associations) != 1 || !proto.Equal(harry.Associations[0], expectedAssociation) {
+		t.Errorf("result associations do not equal expected; result: %+v, expected %+v", harry.Associations, expectedAssociation)
+	}
+	if len(harry.Aliases) != 2 || harry.Aliases[0] != "@I3@" || harry.Aliases[1] != "The Boy Who Lived" {
+		t.Errorf("unexpected aliases: %+v", harry.Aliases)
+	}
+}
@@ -0,0 +1,104 @@
+package gedcom
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+)
+
+// interpretChangeDateStructure interprets a CHAN structure holding the date and optional time of the last change to a record
+func interpretChangeDateStructure(changeLines []*Line) *Gedcom_ChangeDate {
+	changeDate := &Gedcom_ChangeDate{}
+	forEachSubordinateLine(changeLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "DATE":
+			date := interpretDateStructure(subordinateLines[0])
+			gedcomIndividualDate := date.toGedcomIndividualDate()
+			changeDate.Date = &gedcomIndividualDate
+			forEachSubordinateLine(subordinateLines, func(tag string, timeLines []*Line) {
+				switch tag {
+				case "TIME":
+					changeDate.Time = timeLines[0].Value()
+				}
+			})
+		}
+	})
+	return changeDate
+}
+
+// createAndWriteChangeDateLines writes a CHAN structure at the given level, nil change dates are skipped
+func createAndWriteChangeDateLines(changeDate *Gedcom_ChangeDate, changeLevel int, lineCounter *int, buf *bytes.Buffer) {
+	dateValue := toDateValue(changeDate.GetDate())
+	if dateValue == "" {
+		return
+	}
+
+	err := createAndWriteLine(changeLevel, "", "CHAN", "", lineCounter, buf)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	dateLevel := changeLevel + 1
+	err = createAndWriteLine(dateLevel, "", "DATE", dateValue, lineCounter, buf)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if changeDate.Time != "" {
+		err := createAndWriteLine(dateLevel+1, "", "TIME", changeDate.Time, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+var changeTimeLayouts = []string{"15:04:05.999999999", "15:04:05", "15:04"}
+
+// changeDateToTime converts a change date to a point in time.
+// Change dates don't hold a time zone, so they're interpreted as UTC.
+func changeDateToTime(changeDate *Gedcom_ChangeDate) (time.Time, error) {
+	date := changeDate.GetDate()
+	year, err := strconv.Atoi(date.GetYear())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid change date year: %s", date.GetYear())
+	}
+	month, day := 1, 1
+	if date.GetMonth() != "" {
+		if month, err = strconv.Atoi(date.GetMonth()); err != nil {
+			return time.Time{}, fmt.Errorf("invalid change date month: %s", date.GetMonth())
+		}
+	}
+	if date.GetDay() != "" {
+		if day, err = strconv.Atoi(date.GetDay()); err != nil {
+			return time.Time{}, fmt.Errorf("invalid change date day: %s", date.GetDay())
+		}
+	}
+
+	var timeOfDay time.Time
+	if changeDate.GetTime() != "" {
+		for _, layout := range changeTimeLayouts {
+			if timeOfDay, err = time.Parse(layout, changeDate.GetTime()); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid change time: %s", changeDate.GetTime())
+		}
+	}
+
+	return time.Date(year, time.Month(month), day, timeOfDay.Hour(), timeOfDay.Minute(), timeOfDay.Second(), timeOfDay.Nanosecond(), time.UTC), nil
+}
+
+// changedAfter reports whether a change date lies after the given timestamp, records without a valid change date never do
+func changedAfter(changeDate *Gedcom_ChangeDate, timestamp time.Time) bool {
+	if changeDate == nil {
+		return false
+	}
+	changeTime, err := changeDateToTime(changeDate)
+	if err != nil {
+		return false
+	}
+	return changeTime.After(timestamp)
+}
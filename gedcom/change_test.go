@@ -0,0 +1,98 @@
+package gedcom
+
+import (
+	"testing"
+	"time"
+)
+
+var changedRecordLines = []string{
+	"0 HEAD",
+	"0 @I1@ INDI",
+	"1 NAME Harry /Potter/",
+	"1 REFN 42",
+	"2 TYPE family archive",
+	"1 RIN 1001",
+	"1 _UID 6B2D6A8E1F6D4E1C9F0E0E2F0A9C1D3B",
+	"1 CHAN",
+	"2 DATE 31 JUL 1980",
+	"3 TIME 23:59:59",
+	"0 @F1@ FAM",
+	"1 HUSB @I1@",
+	"1 UID 2F4C0B6A-3D1E-4B5A-9C8D-7E6F5A4B3C2D",
+	"1 CHAN",
+	"2 DATE 1 JAN 1970",
+	"0 @N1@ NOTE The chosen one",
+	"1 CHAN",
+	"2 DATE 2 AUG 1980",
+	"0 @S1@ SOUR",
+	"1 CHAN",
+	"2 DATE 1 AUG 1980",
+	"3 TIME 00:00",
+	"0 @R1@ REPO",
+	"1 NAME Hogwarts Library",
+	"0 TRLR",
+}
+
+func TestRecordIdentification(t *testing.T) {
+	g := interpretGedcomLines(changedRecordLines)
+	if len(g.Individuals) != 1 {
+		t.Fatalf("expected exactly one individual, got %d", len(g.Individuals))
+	}
+	i := g.Individuals[0]
+	if len(i.UserReferences) != 1 || i.UserReferences[0].Number != "42" || i.UserReferences[0].Type != "family archive" {
+		t.Errorf("unexpected user references: %+v", i.UserReferences)
+	}
+	if i.AutomatedRecordId != "1001" {
+		t.Errorf("unexpected automated record id: %s", i.AutomatedRecordId)
+	}
+	if len(i.UniqueIds) != 1 || i.UniqueIds[0] != "6B2D6A8E1F6D4E1C9F0E0E2F0A9C1D3B" {
+		t.Errorf("unexpected unique ids: %+v", i.UniqueIds)
+	}
+	if i.ChangeDate.GetTime() != "23:59:59" || toDateValue(i.ChangeDate.GetDate()) != "31 JUL 1980" {
+		t.Errorf("unexpected change date: %+v", i.ChangeDate)
+	}
+	if len(g.Families) != 1 || len(g.Families[0].UniqueIds) != 1 {
+		t.Errorf("expected family with a unique id, got %+v", g.Families)
+	}
+}
+
+func TestRecordsChangedAfter(t *testing.T) {
+	g := interpretGedcomLines(changedRecordLines)
+	changed := g.RecordsChangedAfter(time.Date(1980, time.July, 31, 23, 59, 58, 0, time.UTC))
+	if len(changed.Individuals) != 1 || len(changed.Notes) != 1 || len(changed.Sources) != 1 {
+		t.Errorf("expected changed individual, note and source, got %+v", changed)
+	}
+	if len(changed.Families) != 0 || len(changed.Repositories) != 0 {
+		t.Errorf("expected family and repository without recent change date to be left out, got %+v", changed)
+	}
+
+	changed = g.RecordsChangedAfter(time.Date(1980, time.August, 1, 0, 0, 0, 0, time.UTC))
+	if len(changed.Individuals) != 0 || len(changed.Notes) != 1 || len(changed.Sources) != 0 {
+		t.Errorf("expected only changed note, got %+v", changed)
+	}
+}
+
+func TestUniqueIdTagNormalisation(t *testing.T) {
+	g := interpretGedcomLines(changedRecordLines)
+	for _, c := range []struct {
+		name     string
+		version  GedcomVersion
+		expected string
+	}{
+		{"5.5.1", GedcomVersion551, "1 _UID 2F4C0B6A-3D1E-4B5A-9C8D-7E6F5A4B3C2D"},
+		{"7.0", GedcomVersion7, "1 UID 2F4C0B6A-3D1E-4B5A-9C8D-7E6F5A4B3C2D"},
+	} {
+		buf, err := g.ToSerializedGedcom(&ExportOptions{Version: c.version})
+		if err != nil {
+			t.Fatalf("failed to serialize gedcom with error: %s", err)
+		}
+		lines := splitLines(buf.String())
+		found := false
+		for _, line := range lines {
+			found = found || line == c.expected
+		}
+		if !found {
+			t.Errorf("expected %q in GEDCOM %s output, found %v", c.expected, c.name, lines)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SourceCitations collects the source citations anywhere in a record or structure,
+// e.g. those of an individual itself and of its events, associations, non-events and LDS ordinances
+func SourceCitations(record proto.Message) []*Gedcom_SourceCitation {
+	citations := []*Gedcom_SourceCitation{}
+	forEachStructure(record.ProtoReflect(), func(structure protoreflect.Message) bool {
+		citation, ok := structure.Interface().(*Gedcom_SourceCitation)
+		if ok {
+			citations = append(citations, citation)
+		}
+		return !ok
+	})
+	return citations
+}
+
+// interpretSourceCitationStructure interprets a SOURCE_CITATION,
+// which either points to a source record or describes the source as text
+func interpretSourceCitationStructure(citationLines []*Line) *Gedcom_SourceCitation {
+	citation := &Gedcom_SourceCitation{}
+	if value := citationLines[0].Value(); isPointer(value) {
+		citation.SourceId = value
+	} else {
+		citation.Description = interpretTextStructure(citationLines)
+	}
+	forEachSubordinateLine(citationLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "PAGE":
+			citation.Page = subordinateLines[0].Value()
+		case "QUAY":
+			citation.Quality = subordinateLines[0].Value()
+		case "NOTE", "SNOTE":
+			citation.Notes = append(citation.Notes, interpretNoteLinkStructure(subordinateLines))
+		}
+	})
+	return citation
+}
+
+func createAndWriteSourceCitationLines(citations []*Gedcom_SourceCitation, citationLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, citation := range citations {
+		var err error
+		if citation.SourceId != "" {
+			err = createAndWriteLine(citationLevel, "", "SOUR", citation.SourceId, lineCounter, buf)
+		} else {
+			err = createAndWriteTextLines(citationLevel, "", "SOUR", citation.Description, lineCounter, buf)
+		}
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		createAndWriteTagValueLines(citationLevel+1, []tagValue{
+			{"PAGE", citation.Page},
+			{"QUAY", citation.Quality},
+		}, lineCounter, buf)
+		createAndWriteNoteLinkLines(citation.Notes, citationLevel+1, lineCounter, buf)
+	}
+}
+
+// interpretRepositoryCitationStructure interprets a SOURCE_REPOSITORY_CITATION pointing to the repository holding a source
+func interpretRepositoryCitationStructure(citationLines []*Line) *Gedcom_RepositoryCitation {
+	citation := &Gedcom_RepositoryCitation{
+		RepositoryId: citationLines[0].Value(),
+	}
+	forEachSubordinateLine(citationLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "CALN":
+			citation.CallNumbers = append(citation.CallNumbers, subordinateLines[0].Value())
+		case "NOTE", "SNOTE":
+			citation.Notes = append(citation.Notes, interpretNoteLinkStructure(subordinateLines))
+		}
+	})
+	return citation
+}
+
+func createAndWriteRepositoryCitationLines(citations []*Gedcom_RepositoryCitation, citationLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, citation := range citations {
+		err := createAndWriteLine(citationLevel, "", "REPO", citation.RepositoryId, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		createAndWriteNoteLinkLines(citation.Notes, citationLevel+1, lineCounter, buf)
+		createAndWriteValueLines(citationLevel+1, "CALN", citation.CallNumbers, lineCounter, buf)
+	}
+}
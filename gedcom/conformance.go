@@ -0,0 +1,308 @@
+package gedcom
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ConformanceVersion is the version of the GEDCOM standard CheckConformance checks against
+const ConformanceVersion = "5.5.1"
+
+const (
+	maxLineLength   = 255
+	maxTagLength    = 31
+	maxXRefIDLength = 22
+	maxLevel        = 99
+)
+
+// ConformanceReport is a machine-readable report on the conformance of a GEDCOM file to the standard
+type ConformanceReport struct {
+	Version     string        `json:"Version"`
+	Conforming  bool          `json:"Conforming"`
+	Lines       int           `json:"Lines"`
+	Records     int           `json:"Records"`
+	Diagnostics []*Diagnostic `json:"Diagnostics"`
+}
+
+var (
+	conformanceLinePattern = regexp.MustCompile(`^(\d+) (?:(@[^ ]+@) )?([^ ]+)(?: (.*))?$`)
+	xRefIDPattern          = regexp.MustCompile(`^@[A-Za-z0-9][^@]*@$`)
+	tagPattern             = regexp.MustCompile(`^_?[A-Za-z0-9][A-Za-z0-9_]*$`)
+)
+
+// conformanceNode is a line of a GEDCOM file along with the lines subordinate to it
+type conformanceNode struct {
+	number       int
+	level        int
+	xRefID       string
+	tag          string
+	value        string
+	subordinates []*conformanceNode
+}
+
+type conformanceChecker struct {
+	recordTagsByXRefIDs map[string]string
+	diagnostics         []*Diagnostic
+}
+
+func (c *conformanceChecker) report(rule string, node *conformanceNode, xRefID string, format string, args ...interface{}) {
+	d := newDiagnostic(rule, SeverityError, xRefID, format, args...)
+	d.Line = node.number
+	c.diagnostics = append(c.diagnostics, d)
+}
+
+// CheckConformance checks the lines of a GEDCOM file against the grammar of GEDCOM 5.5.1:
+// the format of every line, the subordinate tags allowed in every context and how often they may occur,
+// enumerated values, value lengths, pointers and the records required in every file.
+// User-defined tags, starting with an underscore, are allowed anywhere and aren't checked any further.
+func CheckConformance(lines []string) *ConformanceReport {
+	c := &conformanceChecker{
+		recordTagsByXRefIDs: map[string]string{},
+	}
+	records := c.readConformanceNodes(lines)
+
+	recordCount := 0
+	for _, record := range records {
+		if record.tag != "HEAD" && record.tag != "TRLR" {
+			recordCount++
+		}
+		if record.xRefID == "" {
+			continue
+		}
+		if _, duplicate := c.recordTagsByXRefIDs[record.xRefID]; duplicate {
+			c.report("duplicate-xref", record, record.xRefID, "xRefId is used by more than one record")
+			continue
+		}
+		c.recordTagsByXRefIDs[record.xRefID] = record.tag
+	}
+
+	c.checkRecords(records)
+
+	conforming := true
+	for _, d := range c.diagnostics {
+		if d.Severity == SeverityError {
+			conforming = false
+		}
+	}
+	return &ConformanceReport{
+		Version:     ConformanceVersion,
+		Conforming:  conforming,
+		Lines:       len(lines),
+		Records:     recordCount,
+		Diagnostics: c.diagnostics,
+	}
+}
+
+// readConformanceNodes checks the format of every line and arranges them into a tree of records
+func (c *conformanceChecker) readConformanceNodes(lines []string) []*conformanceNode {
+	var records []*conformanceNode
+	var stack []*conformanceNode
+	for i, line := range lines {
+		if i == 0 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+		node := &conformanceNode{number: i + 1}
+		if utf8.RuneCountInString(line) > maxLineLength {
+			c.report("line-length", node, "", "line is longer than %d characters", maxLineLength)
+		}
+		match := conformanceLinePattern.FindStringSubmatch(line)
+		if match == nil {
+			c.report("line-format", node, "", "line doesn't match the format <level> [<xref>] <tag> [<value>]")
+			continue
+		}
+		node.xRefID, node.tag, node.value = match[2], match[3], match[4]
+		level, err := strconv.Atoi(match[1])
+		if err != nil || level > maxLevel || (len(match[1]) > 1 && match[1][0] == '0') {
+			c.report("level", node, "", "invalid level %s", match[1])
+			continue
+		}
+		node.level = level
+		if !tagPattern.MatchString(node.tag) || len(node.tag) > maxTagLength {
+			c.report("tag", node, "", "invalid tag %s", node.tag)
+		}
+		if node.xRefID != "" && !isValidXRefID(node.xRefID) {
+			c.report("xref-format", node, "", "invalid xRefId %s", node.xRefID)
+		}
+
+		if level > len(stack) {
+			c.report("level", node, "", "level %d doesn't follow a line of level %d", level, level-1)
+			continue
+		}
+		stack = append(stack[:level], node)
+		if level == 0 {
+			records = append(records, node)
+		} else {
+			parent := stack[level-1]
+			parent.subordinates = append(parent.subordinates, node)
+		}
+	}
+	return records
+}
+
+func isValidXRefID(value string) bool {
+	return xRefIDPattern.MatchString(value) && len(value) <= maxXRefIDLength
+}
+
+func (c *conformanceChecker) checkRecords(records []*conformanceNode) {
+	if len(records) == 0 {
+		c.diagnostics = append(c.diagnostics, newDiagnostic("required-record", SeverityError, "", "file holds no records"))
+		return
+	}
+	if records[0].tag != "HEAD" {
+		c.report("required-record", records[0], "", "file doesn't start with a header (HEAD)")
+	}
+	if last := records[len(records)-1]; last.tag != "TRLR" {
+		c.report("required-record", last, "", "file doesn't end with a trailer (TRLR)")
+	}
+
+	counts := map[string]int{}
+	for _, record := range records {
+		counts[record.tag]++
+		if strings.HasPrefix(record.tag, "_") {
+			continue
+		}
+		structure, ok := conformanceRecordStructures[record.tag]
+		if !ok {
+			c.report("subordinate-tag", record, record.xRefID, "record type %s isn't allowed", record.tag)
+			continue
+		}
+		switch {
+		case (record.tag == "HEAD" || record.tag == "TRLR") && record.xRefID != "":
+			c.report("xref-format", record, record.xRefID, "%s can't have an xRefId", record.tag)
+		case record.tag != "HEAD" && record.tag != "TRLR" && record.xRefID == "":
+			c.report("xref-format", record, "", "%s record doesn't have an xRefId", record.tag)
+		}
+		c.checkStructure(record, structure, record.xRefID)
+	}
+	for _, tag := range []string{"HEAD", "TRLR", submissionRecordTag} {
+		if counts[tag] > 1 {
+			c.report("cardinality", records[0], "", "file holds %d %s records, at most 1 is allowed", counts[tag], tag)
+		}
+	}
+	if counts["HEAD"] == 0 {
+		c.report("required-record", records[0], "", "file doesn't hold a header (HEAD)")
+	}
+	if counts[submitterRecordTag] == 0 {
+		c.report("required-record", records[0], "", "file doesn't hold a submitter record (SUBM)")
+	}
+}
+
+// checkStructure checks a line and everything subordinate to it against the structure it should conform to
+func (c *conformanceChecker) checkStructure(node *conformanceNode, structureName string, recordXRefID string) {
+	structure, ok := conformanceGrammar[structureName]
+	if !ok {
+		return
+	}
+	c.checkValue(node, structure.value, recordXRefID)
+
+	counts := map[string]int{}
+	for _, subordinate := range node.subordinates {
+		counts[subordinate.tag]++
+		if strings.HasPrefix(subordinate.tag, "_") {
+			continue
+		}
+		rule, ok := structure.subordinates[subordinate.tag]
+		if !ok {
+			c.report("subordinate-tag", subordinate, recordXRefID, "%s isn't allowed under %s", subordinate.tag, node.tag)
+			continue
+		}
+		if subordinate.xRefID != "" {
+			c.report("xref-format", subordinate, recordXRefID, "only records can have an xRefId")
+		}
+		c.checkStructure(subordinate, rule.structure, recordXRefID)
+	}
+
+	tags := make([]string, 0, len(structure.subordinates))
+	for tag := range structure.subordinates {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		rule := structure.subordinates[tag]
+		if counts[tag] < rule.min {
+			c.report("cardinality", node, recordXRefID, "%s requires %s", node.tag, tag)
+		}
+		if rule.max > 0 && counts[tag] > rule.max {
+			c.report("cardinality", node, recordXRefID, "%s allows at most %d %s, found %d", node.tag, rule.max, tag, counts[tag])
+		}
+	}
+}
+
+func (c *conformanceChecker) checkValue(node *conformanceNode, rule conformanceValue, recordXRefID string) {
+	if node.value == "" {
+		if rule.required {
+			c.report("value-required", node, recordXRefID, "%s requires a value", node.tag)
+		}
+		return
+	}
+	switch rule.kind {
+	case valueNone:
+		c.report("value-not-allowed", node, recordXRefID, "%s can't have a value", node.tag)
+	case valueText:
+		c.checkValueLength(node, rule.maxLength, recordXRefID)
+	case valuePointer:
+		c.checkPointer(node, rule.pointerTo, recordXRefID)
+	case valuePointerOrText:
+		if strings.HasPrefix(node.value, "@") && strings.HasSuffix(node.value, "@") {
+			c.checkPointer(node, rule.pointerTo, recordXRefID)
+		} else {
+			c.checkValueLength(node, rule.maxLength, recordXRefID)
+		}
+	case valueEnum:
+		for _, value := range rule.enum {
+			if strings.EqualFold(value, node.value) {
+				return
+			}
+		}
+		c.report("enumeration", node, recordXRefID, "invalid %s value %s, expected one of: %s", node.tag, node.value, strings.Join(rule.enum, "|"))
+	}
+}
+
+func (c *conformanceChecker) checkValueLength(node *conformanceNode, maxLength int, recordXRefID string) {
+	if maxLength > 0 && utf8.RuneCountInString(node.value) > maxLength {
+		c.report("value-length", node, recordXRefID, "%s value is longer than %d characters", node.tag, maxLength)
+	}
+}
+
+func (c *conformanceChecker) checkPointer(node *conformanceNode, recordTag string, recordXRefID string) {
+	if !isXRefID(node.value) {
+		c.report("pointer", node, recordXRefID, "%s requires a pointer to a %s record, found %s", node.tag, recordTag, node.value)
+		return
+	}
+	if !isValidXRefID(node.value) {
+		c.report("xref-format", node, recordXRefID, "invalid pointer %s", node.value)
+		return
+	}
+	pointedTag, ok := c.recordTagsByXRefIDs[node.value]
+	if !ok {
+		c.report("pointer", node, recordXRefID, "%s points to missing %s record %s", node.tag, recordTag, node.value)
+		return
+	}
+	if pointedTag != recordTag {
+		c.report("pointer", node, recordXRefID, "%s points to %s record %s, expected a %s record", node.tag, pointedTag, node.value, recordTag)
+	}
+}
+
+func (r *ConformanceReport) String() string {
+	var sb strings.Builder
+	errors := 0
+	for _, d := range r.Diagnostics {
+		if d.Severity == SeverityError {
+			errors++
+		}
+	}
+	if r.Conforming {
+		sb.WriteString(fmt.Sprintf("conforms to GEDCOM %s (%d lines, %d records)\n", r.Version, r.Lines, r.Records))
+	} else {
+		sb.WriteString(fmt.Sprintf("doesn't conform to GEDCOM %s (%d lines, %d records, %d errors)\n", r.Version, r.Lines, r.Records, errors))
+	}
+	for _, d := range r.Diagnostics {
+		sb.WriteString(d.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
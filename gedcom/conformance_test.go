@@ -0,0 +1,97 @@
+package gedcom
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConformanceGrammar(t *testing.T) {
+	for _, structure := range conformanceRecordStructures {
+		if _, ok := conformanceGrammar[structure]; !ok {
+			t.Errorf("record structure %s isn't defined", structure)
+		}
+	}
+	for name, structure := range conformanceGrammar {
+		for tag, subordinate := range structure.subordinates {
+			if _, ok := conformanceGrammar[subordinate.structure]; !ok {
+				t.Errorf("structure %s of %s under %s isn't defined", subordinate.structure, tag, name)
+			}
+		}
+	}
+}
+
+var conformingGedcomLines = []string{
+	"0 HEAD",
+	"1 SOUR gedcom-parser",
+	"1 SUBM @U1@",
+	"1 GEDC",
+	"2 VERS 5.5.1",
+	"2 FORM LINEAGE-LINKED",
+	"1 CHAR UTF-8",
+	"0 @U1@ SUBM",
+	"1 NAME Jane Doe",
+	"0 @I1@ INDI",
+	"1 NAME Harry /Potter/",
+	"1 SEX M",
+	"1 BIRT",
+	"2 DATE 31 JUL 1980",
+	"2 SOUR @S1@",
+	"3 QUAY 3",
+	"1 FAMC @F1@",
+	"2 PEDI birth",
+	"1 _UID 1234",
+	"0 @F1@ FAM",
+	"1 CHIL @I1@",
+	"1 MARR",
+	"0 @S1@ SOUR",
+	"1 TITL Philosopher's Stone",
+	"0 TRLR",
+}
+
+func TestCheckConformance(t *testing.T) {
+	report := CheckConformance(conformingGedcomLines)
+	if !report.Conforming || len(report.Diagnostics) > 0 || report.Records != 4 {
+		t.Errorf("expected conforming report with 4 records, got %+v: %v", report, report.Diagnostics)
+	}
+
+	cases := []struct {
+		lines []string
+		rule  string
+		line  int
+	}{
+		{[]string{"0 HEAD", "1 SOUR x", "1 GEDC", "2 VERS 5.5.1", "2 FORM LINEAGE-LINKED", "1 CHAR UTF-8", "0 TRLR"}, "cardinality", 1},
+		{[]string{"0 @I1@ INDI", "1 SEX X"}, "enumeration", 2},
+		{[]string{"0 @I1@ INDI", "1 SEX M", "1 SEX F"}, "cardinality", 1},
+		{[]string{"0 @I1@ INDI", "1 FAMC @F1@", "2 PEDI step"}, "enumeration", 3},
+		{[]string{"0 @I1@ INDI", "1 RESN secret"}, "enumeration", 2},
+		{[]string{"0 @I1@ INDI", "1 SOUR @S1@", "2 QUAY 4"}, "enumeration", 3},
+		{[]string{"0 @I1@ INDI", "1 HUSB @I2@"}, "subordinate-tag", 2},
+		{[]string{"0 @I1@ INDI", "1 NAME " + strings.Repeat("x", 121)}, "value-length", 2},
+		{[]string{"0 @I1@ INDI", "1 NOTE " + strings.Repeat("x", 250)}, "line-length", 2},
+		{[]string{"0 @I1@ INDI", "2 NAME Harry"}, "level", 2},
+		{[]string{"0 @I1@ INDI", "1 FAMS @I1@"}, "pointer", 2},
+		{[]string{"0 @I1@ INDI", "1 FAMS @F404@"}, "pointer", 2},
+		{[]string{"0 @I 1@ INDI"}, "tag", 1},
+		{[]string{"0 @@I1@ INDI"}, "xref-format", 1},
+		{[]string{"0 @I1@ INDI", "0 @I1@ FAM"}, "duplicate-xref", 2},
+		{[]string{"0 INDI"}, "xref-format", 1},
+		{[]string{"INDI"}, "line-format", 1},
+	}
+	for _, c := range cases {
+		lines := append(append([]string{}, conformingGedcomLines[:len(conformingGedcomLines)-1]...), c.lines...)
+		offset := len(conformingGedcomLines) - 1
+		if c.lines[0] == "0 HEAD" {
+			lines, offset = c.lines, 0
+		}
+		report := CheckConformance(lines)
+		found := false
+		for _, d := range report.Diagnostics {
+			if d.Rule == c.rule && d.Line == c.line+offset {
+				found = true
+			}
+		}
+		if !found || report.Conforming {
+			t.Errorf("expected %s diagnostic on line %d of %v, got %v", c.rule, c.line, c.lines, report.Diagnostics)
+		}
+	}
+}
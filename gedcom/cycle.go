@@ -0,0 +1,126 @@
+package gedcom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AncestryCyclePolicy determines what happens to individuals who are their own ancestor
+type AncestryCyclePolicy string
+
+const (
+	// AncestryCyclesReport only reports cycles in the ancestry, leaving them as is
+	AncestryCyclesReport AncestryCyclePolicy = ""
+	// AncestryCyclesBreak breaks every cycle in the ancestry by removing the child from the family that closes it
+	AncestryCyclesBreak AncestryCyclePolicy = "break"
+)
+
+// ParseAncestryCyclePolicy parses an ancestry cycle policy, where an empty value or report only reports cycles
+func ParseAncestryCyclePolicy(value string) (AncestryCyclePolicy, error) {
+	switch AncestryCyclePolicy(value) {
+	case AncestryCyclesReport, "report":
+		return AncestryCyclesReport, nil
+	case AncestryCyclesBreak:
+		return AncestryCyclesBreak, nil
+	}
+	return AncestryCyclesReport, fmt.Errorf("invalid ancestry cycle policy %s, expected one of: report|break", value)
+}
+
+// parentChildEdge links a parent to a child through the family they're both a member of
+type parentChildEdge struct {
+	childId string
+	family  *Gedcom_Family
+}
+
+// ValidateAncestryCycles finds individuals who are their own ancestor by following the parent-child relations of families,
+// reporting the path of every cycle found. Under the break policy, every cycle is broken by removing the child
+// that closes it from the family, which leaves the parent-child relations free of cycles.
+func (g *ConcurrencySafeGedcom) ValidateAncestryCycles(policy AncestryCyclePolicy) []*Diagnostic {
+	g.lock()
+	defer g.unlock()
+
+	var individualIds []string
+	edges := map[string][]parentChildEdge{}
+	for _, f := range g.Families {
+		for _, parentId := range []string{f.FatherId, f.MotherId} {
+			if parentId == "" {
+				continue
+			}
+			if _, ok := edges[parentId]; !ok {
+				individualIds = append(individualIds, parentId)
+			}
+			for _, childId := range f.ChildIds {
+				edges[parentId] = append(edges[parentId], parentChildEdge{childId, f})
+			}
+		}
+	}
+
+	individuals := g.IndividualsByIds()
+	removedEdges := map[parentChildEdge]bool{}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := map[string]int{}
+	var path []string
+	var diagnostics []*Diagnostic
+	var visit func(id string)
+	visit = func(id string) {
+		states[id] = visiting
+		path = append(path, id)
+		for _, edge := range edges[id] {
+			if removedEdges[edge] {
+				continue
+			}
+			switch states[edge.childId] {
+			case unvisited:
+				visit(edge.childId)
+			case visiting:
+				cyclePath := strings.Join(append(cycleFrom(path, edge.childId), edge.childId), " -> ")
+				if policy != AncestryCyclesBreak {
+					diagnostics = append(diagnostics, newDiagnostic("ancestry-cycle", SeverityError, edge.childId,
+						"individual is their own ancestor: %s", cyclePath))
+					continue
+				}
+				removeChild(edge.family, edge.childId, individuals[edge.childId])
+				removedEdges[edge] = true
+				diagnostics = append(diagnostics, newDiagnostic("ancestry-cycle", SeverityError, edge.childId,
+					"individual is their own ancestor: %s, removing child from family %s", cyclePath, edge.family.Id))
+			}
+		}
+		path = path[:len(path)-1]
+		states[id] = visited
+	}
+	for _, id := range individualIds {
+		if states[id] == unvisited {
+			visit(id)
+		}
+	}
+	return diagnostics
+}
+
+// cycleFrom returns the part of a path of ancestors and descendants that starts at the given ancestor
+func cycleFrom(path []string, ancestorId string) []string {
+	for i, id := range path {
+		if id == ancestorId {
+			return append([]string{}, path[i:]...)
+		}
+	}
+	return nil
+}
+
+// removeChild removes a child from a family, along with the child's link to the family
+func removeChild(family *Gedcom_Family, childId string, child *Gedcom_Individual) {
+	childIds := []string{}
+	for _, id := range family.ChildIds {
+		if id != childId {
+			childIds = append(childIds, id)
+		}
+	}
+	family.ChildIds = childIds
+	if child != nil {
+		child.ChildToFamilyLinks = familyLinksExcept(child.ChildToFamilyLinks, map[string]bool{family.Id: true})
+	}
+}
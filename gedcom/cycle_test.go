@@ -0,0 +1,50 @@
+package gedcom
+
+import (
+	"strings"
+	"testing"
+)
+
+func ancestryCycleTestGedcom() *ConcurrencySafeGedcom {
+	g := NewConcurrencySafeGedcom()
+	g.Individuals = []*Gedcom_Individual{
+		{Id: "@I1@"},
+		{Id: "@I2@", ChildToFamilyLinks: []*Gedcom_Individual_FamilyLink{{FamilyId: "@F1@"}}},
+		{Id: "@I3@", ChildToFamilyLinks: []*Gedcom_Individual_FamilyLink{{FamilyId: "@F2@"}}},
+		{Id: "@I4@"},
+	}
+	g.Families = []*Gedcom_Family{
+		{Id: "@F1@", FatherId: "@I1@", MotherId: "@I4@", ChildIds: []string{"@I2@"}},
+		{Id: "@F2@", FatherId: "@I2@", ChildIds: []string{"@I3@"}},
+		{Id: "@F3@", FatherId: "@I3@", ChildIds: []string{"@I1@"}},
+	}
+	return g
+}
+
+func TestValidateAncestryCycles(t *testing.T) {
+	g := ancestryCycleTestGedcom()
+	diagnostics := g.ValidateAncestryCycles(AncestryCyclesReport)
+	if len(diagnostics) != 1 || !strings.Contains(diagnostics[0].Message, "@I1@ -> @I2@ -> @I3@ -> @I1@") {
+		t.Fatalf("expected a single cycle @I1@ -> @I2@ -> @I3@ -> @I1@, got %v", diagnostics)
+	}
+	if len(g.Families[2].ChildIds) != 1 {
+		t.Errorf("expected report policy to leave families untouched")
+	}
+
+	g = ancestryCycleTestGedcom()
+	diagnostics = g.ValidateAncestryCycles(AncestryCyclesBreak)
+	if len(diagnostics) != 1 || len(g.Families[2].ChildIds) != 0 {
+		t.Errorf("expected cycle to be broken by removing @I1@ from @F3@, got %v and %v", diagnostics, g.Families[2].ChildIds)
+	}
+	if len(g.ValidateAncestryCycles(AncestryCyclesReport)) != 0 {
+		t.Errorf("expected no cycles left after breaking them")
+	}
+
+	g = NewConcurrencySafeGedcom()
+	g.Individuals = []*Gedcom_Individual{{Id: "@I1@", ChildToFamilyLinks: []*Gedcom_Individual_FamilyLink{{FamilyId: "@F1@"}}}}
+	g.Families = []*Gedcom_Family{{Id: "@F1@", FatherId: "@I1@", MotherId: "@I1@", ChildIds: []string{"@I1@"}}}
+	diagnostics = g.ValidateAncestryCycles(AncestryCyclesBreak)
+	if len(diagnostics) != 1 || len(g.Families[0].ChildIds) != 0 || len(g.Individuals[0].ChildToFamilyLinks) != 0 {
+		t.Errorf("expected individual to be removed as their own child, got %v", diagnostics)
+	}
+}
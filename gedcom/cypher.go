@@ -0,0 +1,214 @@
+package gedcom
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+/*
+Cypher scripts create a graph of a gedcom in e.g. Neo4j, with nodes for its individuals (Person), families (Family),
+sources (Source) and the places of events (Place). Individuals are children in (CHILD_OF) and spouses in (SPOUSE_IN)
+families, individuals and families cite (CITES) sources and their events occurred at (OCCURRED_AT) places.
+Nodes are merged on their xRefId or the name of their place, so running a script twice doesn't duplicate the graph.
+*/
+
+// names of the node labels and relationship types of Cypher scripts
+const (
+	PersonLabel            = "Person"
+	FamilyLabel            = "Family"
+	SourceLabel            = "Source"
+	PlaceLabel             = "Place"
+	ChildOfRelationship    = "CHILD_OF"
+	SpouseInRelationship   = "SPOUSE_IN"
+	CitesRelationship      = "CITES"
+	OccurredAtRelationship = "OCCURRED_AT"
+)
+
+// cypherProperty is a property of a node or relationship, which is left out if its value is empty
+type cypherProperty struct {
+	key   string
+	value string
+}
+
+// ToCypher exports a gedcom as a Cypher script of MERGE statements creating its graph
+func (g *ConcurrencySafeGedcom) ToCypher(options *ExportOptions) (*[]byte, error) {
+	gedcom := g.exportedGedcom(options)
+	individuals := append([]*Gedcom_Individual{}, gedcom.Individuals...)
+	sort.Slice(individuals, func(i, j int) bool {
+		return individuals[i].Id < individuals[j].Id
+	})
+	families := append([]*Gedcom_Family{}, gedcom.Families...)
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].Id < families[j].Id
+	})
+	sources := append([]*Gedcom_Source{}, gedcom.Sources...)
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Id < sources[j].Id
+	})
+
+	buf := bytes.NewBuffer([]byte{})
+	for _, label := range []string{PersonLabel, FamilyLabel, SourceLabel} {
+		buf.WriteString(fmt.Sprintf("CREATE CONSTRAINT %s_id IF NOT EXISTS FOR (n:%s) REQUIRE n.id IS UNIQUE;\n", strings.ToLower(label), label))
+	}
+	buf.WriteString(fmt.Sprintf("CREATE CONSTRAINT place_name IF NOT EXISTS FOR (n:%s) REQUIRE n.name IS UNIQUE;\n", PlaceLabel))
+
+	buf.WriteString("\n// sources\n")
+	sourceIds := map[string]bool{}
+	for _, s := range sources {
+		sourceIds[s.Id] = true
+		writeCypherNode(buf, SourceLabel, "id", s.Id, []cypherProperty{{"automatedRecordId", s.AutomatedRecordId}})
+	}
+
+	buf.WriteString("\n// places\n")
+	places := map[string]bool{}
+	for _, i := range individuals {
+		for _, eventType := range IndividualEventTypes {
+			for _, event := range *eventType.Events(i) {
+				if event.Place != "" {
+					places[event.Place] = true
+				}
+			}
+		}
+	}
+	for _, f := range families {
+		for _, event := range f.MarriageEvents {
+			if event.Place != "" {
+				places[event.Place] = true
+			}
+		}
+	}
+	for _, place := range sortedKeys(places) {
+		writeCypherNode(buf, PlaceLabel, "name", place, nil)
+	}
+
+	buf.WriteString("\n// individuals\n")
+	individualIds := map[string]bool{}
+	pedigreesByFamilyAndChildIds := map[[2]string]string{}
+	for _, i := range individuals {
+		individualIds[i.Id] = true
+		for _, link := range i.ChildToFamilyLinks {
+			pedigreesByFamilyAndChildIds[[2]string{link.FamilyId, i.Id}] = link.Pedigree
+		}
+		var name *Gedcom_Individual_Name
+		if len(i.Names) > 0 {
+			name = i.Names[0]
+		}
+		properties := []cypherProperty{
+			{"name", strings.TrimSpace(name.GetGivenName() + " " + name.GetSurname())},
+			{"givenName", name.GetGivenName()},
+			{"surname", name.GetSurname()},
+			{"gender", i.Gender},
+		}
+		if len(i.BirthEvents) > 0 {
+			properties = append(properties, cypherProperty{"birthDate", DateValue(i.BirthEvents[0].Date)})
+		}
+		if len(i.DeathEvents) > 0 {
+			properties = append(properties, cypherProperty{"deathDate", DateValue(i.DeathEvents[0].Date)})
+		}
+		writeCypherNode(buf, PersonLabel, "id", i.Id, properties)
+		for _, eventType := range IndividualEventTypes {
+			for _, event := range *eventType.Events(i) {
+				writeCypherEvent(buf, PersonLabel, i.Id, eventType.Tag, event)
+			}
+		}
+		writeCypherCitations(buf, PersonLabel, i.Id, i.ProtoReflect(), sourceIds)
+	}
+
+	buf.WriteString("\n// families\n")
+	for _, f := range families {
+		writeCypherNode(buf, FamilyLabel, "id", f.Id, nil)
+		for _, event := range f.MarriageEvents {
+			writeCypherEvent(buf, FamilyLabel, f.Id, MarriageEventTag, event)
+		}
+		writeCypherCitations(buf, FamilyLabel, f.Id, f.ProtoReflect(), sourceIds)
+		for _, childId := range f.ChildIds {
+			if individualIds[childId] {
+				writeCypherRelationship(buf, PersonLabel, "id", childId, ChildOfRelationship, FamilyLabel, "id", f.Id, nil,
+					[]cypherProperty{{"pedigree", pedigreesByFamilyAndChildIds[[2]string{f.Id, childId}]}})
+			}
+		}
+		for _, spouse := range []struct {
+			id   string
+			role string
+		}{{f.FatherId, "father"}, {f.MotherId, "mother"}} {
+			if individualIds[spouse.id] {
+				writeCypherRelationship(buf, PersonLabel, "id", spouse.id, SpouseInRelationship, FamilyLabel, "id", f.Id, nil, []cypherProperty{{"role", spouse.role}})
+			}
+		}
+	}
+
+	cypher := buf.Bytes()
+	return &cypher, nil
+}
+
+func writeCypherNode(buf *bytes.Buffer, label string, key string, value string, properties []cypherProperty) {
+	buf.WriteString(fmt.Sprintf("MERGE (n:%s {%s: %s})", label, key, cypherString(value)))
+	writeCypherSet(buf, "n", properties)
+	buf.WriteString(";\n")
+}
+
+// writeCypherRelationship merges a relationship between two nodes, which is told apart from other relationships of
+// its type between them by its key properties
+func writeCypherRelationship(buf *bytes.Buffer, fromLabel string, fromKey string, fromValue string, relationship string, toLabel string, toKey string, toValue string, keyProperties []cypherProperty, properties []cypherProperty) {
+	buf.WriteString(fmt.Sprintf("MATCH (a:%s {%s: %s}), (b:%s {%s: %s}) MERGE (a)-[r:%s%s]->(b)",
+		fromLabel, fromKey, cypherString(fromValue), toLabel, toKey, cypherString(toValue), relationship, cypherMap(keyProperties)))
+	writeCypherSet(buf, "r", properties)
+	buf.WriteString(";\n")
+}
+
+func writeCypherSet(buf *bytes.Buffer, variable string, properties []cypherProperty) {
+	assignments := []string{}
+	for _, property := range properties {
+		if property.value != "" {
+			assignments = append(assignments, fmt.Sprintf("%s.%s = %s", variable, property.key, cypherString(property.value)))
+		}
+	}
+	if len(assignments) > 0 {
+		buf.WriteString(" SET " + strings.Join(assignments, ", "))
+	}
+}
+
+func writeCypherEvent(buf *bytes.Buffer, label string, id string, tag string, event *Gedcom_Individual_Event) {
+	if event.Place == "" {
+		return
+	}
+	writeCypherRelationship(buf, label, "id", id, OccurredAtRelationship, PlaceLabel, "name", event.Place,
+		[]cypherProperty{{"event", tag}, {"date", DateValue(event.Date)}}, nil)
+}
+
+// writeCypherCitations relates a record to the sources cited anywhere in it, e.g. in its associations and non-events
+func writeCypherCitations(buf *bytes.Buffer, label string, id string, record protoreflect.Message, sourceIds map[string]bool) {
+	for _, citation := range SourceCitations(record.Interface()) {
+		if sourceIds[citation.SourceId] {
+			writeCypherRelationship(buf, label, "id", id, CitesRelationship, SourceLabel, "id", citation.SourceId,
+				[]cypherProperty{{"page", citation.Page}}, []cypherProperty{{"quality", citation.Quality}})
+		}
+	}
+}
+
+// cypherMap formats properties as a Cypher map, leaving out empty values
+func cypherMap(properties []cypherProperty) string {
+	entries := []string{}
+	for _, property := range properties {
+		if property.value != "" {
+			entries = append(entries, fmt.Sprintf("%s: %s", property.key, cypherString(property.value)))
+		}
+	}
+	if len(entries) == 0 {
+		return ""
+	}
+	return " {" + strings.Join(entries, ", ") + "}"
+}
+
+// cypherString quotes a string as a Cypher string literal
+func cypherString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	value = strings.ReplaceAll(value, "\r", `\r`)
+	return "'" + value + "'"
+}
@@ -0,0 +1,76 @@
+package gedcom
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToCypher(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"0 @I1@ INDI",
+		"1 NAME John /O'Brien/",
+		"1 SEX M",
+		"1 BIRT",
+		"2 DATE 1 JAN 1900",
+		"2 PLAC London, England",
+		"1 ASSO @I2@",
+		"2 RELA Godfather",
+		"2 SOUR @S1@",
+		"3 PAGE p. 12",
+		"3 QUAY 3",
+		"0 @I2@ INDI",
+		"1 NAME Jim /O'Brien/",
+		"1 FAMC @F1@",
+		"2 PEDI adopted",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"1 CHIL @I2@",
+		"1 MARR",
+		"2 PLAC London, England",
+		"0 @S1@ SOUR",
+		"0 TRLR",
+	})
+	cypher, err := g.ToCypher(nil)
+	if err != nil {
+		t.Fatalf("failed to export Cypher with error: %s", err)
+	}
+	result := string(*cypher)
+	for _, expected := range []string{
+		"CREATE CONSTRAINT person_id IF NOT EXISTS FOR (n:Person) REQUIRE n.id IS UNIQUE;\n",
+		"MERGE (n:Source {id: '@S1@'});\n",
+		"MERGE (n:Place {name: 'London, England'});\n",
+		"MERGE (n:Person {id: '@I1@'}) SET n.name = 'John O\\'Brien', n.givenName = 'John', n.surname = 'O\\'Brien', n.gender = 'MALE', n.birthDate = '1 JAN 1900';\n",
+		"MATCH (a:Person {id: '@I1@'}), (b:Place {name: 'London, England'}) MERGE (a)-[r:OCCURRED_AT {event: 'BIRT', date: '1 JAN 1900'}]->(b);\n",
+		"MATCH (a:Person {id: '@I1@'}), (b:Source {id: '@S1@'}) MERGE (a)-[r:CITES {page: 'p. 12'}]->(b) SET r.quality = '3';\n",
+		"MERGE (n:Family {id: '@F1@'});\n",
+		"MATCH (a:Family {id: '@F1@'}), (b:Place {name: 'London, England'}) MERGE (a)-[r:OCCURRED_AT {event: 'MARR'}]->(b);\n",
+		"MATCH (a:Person {id: '@I2@'}), (b:Family {id: '@F1@'}) MERGE (a)-[r:CHILD_OF]->(b) SET r.pedigree = 'adopted';\n",
+		"MATCH (a:Person {id: '@I1@'}), (b:Family {id: '@F1@'}) MERGE (a)-[r:SPOUSE_IN]->(b) SET r.role = 'father';\n",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expected Cypher script to contain %q, found %s", expected, result)
+		}
+	}
+	// nodes are merged before the relationships matching them
+	if strings.Index(result, "MERGE (n:Family {id: '@F1@'})") > strings.Index(result, "[r:CHILD_OF]") {
+		t.Errorf("expected families to be merged before their children are related to them, found %s", result)
+	}
+}
+
+func TestCypherString(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"London", "'London'"},
+		{"O'Brien", `'O\'Brien'`},
+		{`C:\tree`, `'C:\\tree'`},
+		{"line\nbreak", `'line\nbreak'`},
+	}
+	for _, c := range cases {
+		if actual := cypherString(c.value); actual != c.expected {
+			t.Errorf("cypherString(%q) = %s, expected %s", c.value, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package gedcom
+
+import (
+	"fmt"
+)
+
+// DanglingPointerPolicy determines what happens to pointers to records that don't exist
+type DanglingPointerPolicy string
+
+const (
+	// DanglingPointersRemove removes dangling pointers, along with the structures that only consist of them
+	DanglingPointersRemove DanglingPointerPolicy = ""
+	// DanglingPointersReport only reports dangling pointers, leaving them as is
+	DanglingPointersReport DanglingPointerPolicy = "report"
+	// DanglingPointersPlaceholder creates an empty placeholder record for every record pointed to that doesn't exist
+	DanglingPointersPlaceholder DanglingPointerPolicy = "placeholder"
+)
+
+// ParseDanglingPointerPolicy parses a dangling pointer policy, where an empty value or remove removes dangling pointers
+func ParseDanglingPointerPolicy(value string) (DanglingPointerPolicy, error) {
+	switch DanglingPointerPolicy(value) {
+	case DanglingPointersRemove, "remove":
+		return DanglingPointersRemove, nil
+	case DanglingPointersReport:
+		return DanglingPointersReport, nil
+	case DanglingPointersPlaceholder:
+		return DanglingPointersPlaceholder, nil
+	}
+	return DanglingPointersRemove, fmt.Errorf("invalid dangling pointer policy %s, expected one of: report|remove|placeholder", value)
+}
+
+// ValidatePointerIntegrity ensures integrity of all cross references between records,
+// handling every pointer to a record that doesn't exist according to the given policy.
+// Placeholders are only created for ids that aren't in use by a record of another type,
+// other dangling pointers are removed instead.
+func (g *ConcurrencySafeGedcom) ValidatePointerIntegrity(policy DanglingPointerPolicy) []*Diagnostic {
+	g.lock()
+	defer g.unlock()
+
+	recordTagsByIds := map[string][]string{}
+	forEachRecordId(&g.Gedcom, func(recordTag string, id *string) {
+		recordTagsByIds[*id] = append(recordTagsByIds[*id], recordTag)
+	})
+	return validatePointers(&g.Gedcom, recordTagsByIds, map[string]bool{}, policy)
+}
+
+// validatePointers handles the pointers of a gedcom to records that aren't among the ids of the records by tag,
+// which may be those of a larger gedcom, according to the given policy. Placeholder records are added to the gedcom,
+// and their ids to the ids of the records and the placeholder ids.
+func validatePointers(gedcom *Gedcom, recordTagsByIds map[string][]string, placeholderIds map[string]bool, policy DanglingPointerPolicy) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	removed := false
+	forEachPointer(gedcom, func(recordTag string, pointer *string, holderId string) {
+		holder := holderId
+		if holder == "" {
+			holder = "header"
+		}
+		if placeholderIds[*pointer] && hasRecordTag(recordTagsByIds[*pointer], recordTag) {
+			diagnostics = append(diagnostics, newDiagnostic("dangling-pointer", SeverityError, *pointer,
+				"pointer in %s to missing %s record, pointing to placeholder record", holder, recordTag))
+			return
+		}
+		if hasRecordTag(recordTagsByIds[*pointer], recordTag) {
+			return
+		}
+
+		if policy == DanglingPointersReport {
+			diagnostics = append(diagnostics, newDiagnostic("dangling-pointer", SeverityError, *pointer,
+				"pointer in %s to missing %s record", holder, recordTag))
+			return
+		}
+		if _, used := recordTagsByIds[*pointer]; !used && policy == DanglingPointersPlaceholder && !hasSubmission(recordTag, recordTagsByIds) {
+			if createPlaceholderRecord(gedcom, recordTag, *pointer) {
+				recordTagsByIds[*pointer] = []string{recordTag}
+				placeholderIds[*pointer] = true
+				diagnostics = append(diagnostics, newDiagnostic("dangling-pointer", SeverityError, *pointer,
+					"pointer in %s to missing %s record, created placeholder record", holder, recordTag))
+				return
+			}
+		}
+		diagnostics = append(diagnostics, newDiagnostic("dangling-pointer", SeverityError, *pointer,
+			"pointer in %s to missing %s record, removing pointer", holder, recordTag))
+		*pointer = ""
+		removed = true
+	})
+
+	if removed {
+		removeEmptyPointers(gedcom)
+	}
+	return diagnostics
+}
+
+func hasRecordTag(recordTags []string, recordTag string) bool {
+	for _, t := range recordTags {
+		if t == recordTag {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSubmission reports whether a placeholder of a record type can't be created because it's a submission
+// and a submission record already exists, as only a single submission record is allowed
+func hasSubmission(recordTag string, recordTagsByIds map[string][]string) bool {
+	if recordTag != submissionRecordTag {
+		return false
+	}
+	for _, recordTags := range recordTagsByIds {
+		if hasRecordTag(recordTags, submissionRecordTag) {
+			return true
+		}
+	}
+	return false
+}
+
+// createPlaceholderRecord adds an empty record with the given id, returning whether it could be created.
+// Only a single submission record is allowed, so no placeholder is created for a submission if one already exists.
+func createPlaceholderRecord(gedcom *Gedcom, recordTag string, id string) bool {
+	switch recordTag {
+	case individualRecordTag:
+		gedcom.Individuals = append(gedcom.Individuals, &Gedcom_Individual{Id: id})
+	case familyRecordTag:
+		gedcom.Families = append(gedcom.Families, &Gedcom_Family{Id: id})
+	case multimediaRecordTag:
+		gedcom.Multimedias = append(gedcom.Multimedias, &Gedcom_Multimedia{Id: id})
+	case noteRecordTag:
+		gedcom.Notes = append(gedcom.Notes, &Gedcom_Note{Id: id})
+	case repositoryRecordTag:
+		gedcom.Repositories = append(gedcom.Repositories, &Gedcom_Repository{Id: id})
+	case sourceRecordTag:
+		gedcom.Sources = append(gedcom.Sources, &Gedcom_Source{Id: id})
+	case submitterRecordTag:
+		gedcom.Submitters = append(gedcom.Submitters, &Gedcom_Submitter{Id: id})
+	case submissionRecordTag:
+		if gedcom.Submission != nil {
+			return false
+		}
+		gedcom.Submission = &Gedcom_SubmissionType{Id: id}
+	default:
+		return false
+	}
+	return true
+}
+
+// removeEmptyPointers removes all pointers that have been cleared from lists,
+// along with the links, citations and associations that are left without both a pointer and content of their own.
+func removeEmptyPointers(gedcom *Gedcom) {
+	for _, i := range gedcom.Individuals {
+		for _, eventType := range IndividualEventTypes {
+			removeEmptyEventPointers(*eventType.Events(i))
+		}
+		i.ChildToFamilyLinks = nonEmptyFamilyLinks(i.ChildToFamilyLinks)
+		i.SpouseToFamilyLinks = nonEmptyFamilyLinks(i.SpouseToFamilyLinks)
+		i.Aliases = nonEmptyValues(i.Aliases)
+		associations := []*Gedcom_Individual_Association{}
+		for _, a := range i.Associations {
+			if a.IndividualId != "" {
+				a.SourceCitations = nonEmptySourceCitations(a.SourceCitations)
+				a.Notes = nonEmptyNoteLinks(a.Notes)
+				associations = append(associations, a)
+			}
+		}
+		i.Associations = associations
+		for _, ordinances := range [][]*Gedcom_LdsOrdinance{i.LdsBaptisms, i.LdsConfirmations, i.LdsEndowments, i.LdsChildSealings} {
+			removeEmptyOrdinancePointers(ordinances)
+		}
+		removeEmptyNonEventPointers(i.NonEvents)
+		i.SourceCitations = nonEmptySourceCitations(i.SourceCitations)
+		i.Notes = nonEmptyNoteLinks(i.Notes)
+		i.MultimediaLinks = nonEmptyMultimediaLinks(i.MultimediaLinks)
+	}
+	for _, f := range gedcom.Families {
+		f.ChildIds = nonEmptyValues(f.ChildIds)
+		removeEmptyEventPointers(f.MarriageEvents)
+		removeEmptyOrdinancePointers(f.LdsSpouseSealings)
+		removeEmptyNonEventPointers(f.NonEvents)
+		f.SourceCitations = nonEmptySourceCitations(f.SourceCitations)
+		f.Notes = nonEmptyNoteLinks(f.Notes)
+		f.MultimediaLinks = nonEmptyMultimediaLinks(f.MultimediaLinks)
+	}
+	for _, m := range gedcom.Multimedias {
+		m.SourceCitations = nonEmptySourceCitations(m.SourceCitations)
+		m.Notes = nonEmptyNoteLinks(m.Notes)
+	}
+	for _, r := range gedcom.Repositories {
+		r.Notes = nonEmptyNoteLinks(r.Notes)
+	}
+	for _, s := range gedcom.Sources {
+		s.Notes = nonEmptyNoteLinks(s.Notes)
+		s.MultimediaLinks = nonEmptyMultimediaLinks(s.MultimediaLinks)
+		citations := []*Gedcom_RepositoryCitation{}
+		for _, r := range s.RepositoryCitations {
+			if r.RepositoryId != "" {
+				r.Notes = nonEmptyNoteLinks(r.Notes)
+				citations = append(citations, r)
+			}
+		}
+		s.RepositoryCitations = citations
+	}
+	for _, s := range gedcom.Submitters {
+		s.MultimediaLinks = nonEmptyMultimediaLinks(s.MultimediaLinks)
+	}
+}
+
+func removeEmptyEventPointers(events []*Gedcom_Individual_Event) {
+	for _, e := range events {
+		e.SourceCitations = nonEmptySourceCitations(e.SourceCitations)
+		e.Notes = nonEmptyNoteLinks(e.Notes)
+		e.MultimediaLinks = nonEmptyMultimediaLinks(e.MultimediaLinks)
+	}
+}
+
+func removeEmptyOrdinancePointers(ordinances []*Gedcom_LdsOrdinance) {
+	for _, o := range ordinances {
+		o.SourceCitations = nonEmptySourceCitations(o.SourceCitations)
+		o.Notes = nonEmptyNoteLinks(o.Notes)
+	}
+}
+
+func removeEmptyNonEventPointers(nonEvents []*Gedcom_NonEvent) {
+	for _, n := range nonEvents {
+		n.SourceCitations = nonEmptySourceCitations(n.SourceCitations)
+		n.Notes = nonEmptyNoteLinks(n.Notes)
+	}
+}
+
+func nonEmptyValues(values []string) []string {
+	result := []string{}
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func nonEmptyFamilyLinks(familyLinks []*Gedcom_Individual_FamilyLink) []*Gedcom_Individual_FamilyLink {
+	result := []*Gedcom_Individual_FamilyLink{}
+	for _, familyLink := range familyLinks {
+		if familyLink.FamilyId != "" {
+			familyLink.Notes = nonEmptyNoteLinks(familyLink.Notes)
+			result = append(result, familyLink)
+		}
+	}
+	return result
+}
+
+func nonEmptySourceCitations(citations []*Gedcom_SourceCitation) []*Gedcom_SourceCitation {
+	result := []*Gedcom_SourceCitation{}
+	for _, citation := range citations {
+		if citation.SourceId != "" || citation.Description != "" {
+			citation.Notes = nonEmptyNoteLinks(citation.Notes)
+			result = append(result, citation)
+		}
+	}
+	return result
+}
+
+func nonEmptyNoteLinks(notes []*Gedcom_NoteLink) []*Gedcom_NoteLink {
+	result := []*Gedcom_NoteLink{}
+	for _, note := range notes {
+		if note.NoteId != "" || note.SubmitterText != "" {
+			result = append(result, note)
+		}
+	}
+	return result
+}
+
+// nonEmptyMultimediaLinks keeps the multimedia links that point to a record or embed files of their own
+func nonEmptyMultimediaLinks(links []*Gedcom_MultimediaLink) []*Gedcom_MultimediaLink {
+	result := []*Gedcom_MultimediaLink{}
+	for _, link := range links {
+		if link.MultimediaId != "" || len(link.Files) > 0 {
+			result = append(result, link)
+		}
+	}
+	return result
+}
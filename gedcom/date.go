@@ -2,13 +2,16 @@ package gedcom
 
 import (
 	"github.com/jochenboesmans/gedcom-parser/util"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
 type Date struct {
-	Year  string
-	Month string
-	Day   string
+	Year   string
+	Month  string
+	Day    string
+	Phrase string
 }
 
 func interpretDateStructure(line *Line) Date {
@@ -28,10 +31,103 @@ func interpretDateStructure(line *Line) Date {
 	return date
 }
 
+// interpretDatePhraseStructure interprets a date along with its GEDCOM 7.0 PHRASE,
+// a free-text version of the date for what the date value can't express
+func interpretDatePhraseStructure(dateLines []*Line) Date {
+	date := interpretDateStructure(dateLines[0])
+	forEachSubordinateLine(dateLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "PHRASE", "_PHRASE":
+			date.Phrase = subordinateLines[0].Value()
+		}
+	})
+	return date
+}
+
 func (date *Date) toGedcomIndividualDate() Gedcom_Individual_Date {
 	return Gedcom_Individual_Date{
-		Year:  date.Year,
-		Month: date.Month,
-		Day:   date.Day,
+		Year:   date.Year,
+		Month:  date.Month,
+		Day:    date.Day,
+		Phrase: date.Phrase,
+	}
+}
+
+var yearPattern = regexp.MustCompile(`\d{3,4}`)
+
+// dateYear extracts the year of a date as a number, the last year is used for date ranges and periods
+func dateYear(date *Gedcom_Individual_Date) (int, bool) {
+	years := yearPattern.FindAllString(date.GetYear(), -1)
+	if len(years) == 0 {
+		return 0, false
+	}
+	year, err := strconv.Atoi(years[len(years)-1])
+	if err != nil {
+		return 0, false
+	}
+	return year, true
+}
+
+// earliestEventYear returns the earliest year of the given events
+func earliestEventYear(events []*Gedcom_Individual_Event) (int, bool) {
+	earliest, found := 0, false
+	for _, event := range events {
+		if year, ok := dateYear(event.Date); ok && (!found || year < earliest) {
+			earliest, found = year, true
+		}
+	}
+	return earliest, found
+}
+
+// compareDates compares two dates as precisely as both of them are known, i.e. the month and day are only
+// compared if they're known for both dates. Comparing fails if the year of either date is unknown.
+func compareDates(a *Gedcom_Individual_Date, b *Gedcom_Individual_Date) (int, bool) {
+	yearA, okA := dateYear(a)
+	yearB, okB := dateYear(b)
+	if !okA || !okB {
+		return 0, false
+	}
+	if yearA != yearB {
+		return compareInts(yearA, yearB), true
+	}
+	monthA, errA := strconv.Atoi(a.GetMonth())
+	monthB, errB := strconv.Atoi(b.GetMonth())
+	if errA != nil || errB != nil {
+		return 0, true
+	}
+	if monthA != monthB {
+		return compareInts(monthA, monthB), true
+	}
+	dayA, errA := strconv.Atoi(a.GetDay())
+	dayB, errB := strconv.Atoi(b.GetDay())
+	if errA != nil || errB != nil {
+		return 0, true
+	}
+	return compareInts(dayA, dayB), true
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// earliestEventDate returns the earliest date of the given events
+func earliestEventDate(events []*Gedcom_Individual_Event) (*Gedcom_Individual_Date, bool) {
+	var earliest *Gedcom_Individual_Date
+	for _, event := range events {
+		if _, ok := dateYear(event.Date); !ok {
+			continue
+		}
+		if earliest == nil {
+			earliest = event.Date
+		} else if comparison, _ := compareDates(event.Date, earliest); comparison < 0 {
+			earliest = event.Date
+		}
 	}
+	return earliest, earliest != nil
 }
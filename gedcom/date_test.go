@@ -35,3 +35,23 @@ func TestInterpretDateStructure(t *testing.T) {
 		}
 	}
 }
+
+func TestCompareDates(t *testing.T) {
+	cases := []struct {
+		a, b       *Gedcom_Individual_Date
+		comparison int
+		ok         bool
+	}{
+		{&Gedcom_Individual_Date{Year: "1900"}, &Gedcom_Individual_Date{Year: "1901"}, -1, true},
+		{&Gedcom_Individual_Date{Year: "1900", Month: "03"}, &Gedcom_Individual_Date{Year: "1900"}, 0, true},
+		{&Gedcom_Individual_Date{Year: "1900", Month: "03", Day: "12"}, &Gedcom_Individual_Date{Year: "1900", Month: "03", Day: "5"}, 1, true},
+		{&Gedcom_Individual_Date{Year: "ABT 1900"}, &Gedcom_Individual_Date{Year: "1850"}, 1, true},
+		{&Gedcom_Individual_Date{}, &Gedcom_Individual_Date{Year: "1850"}, 0, false},
+	}
+	for _, c := range cases {
+		comparison, ok := compareDates(c.a, c.b)
+		if comparison != c.comparison || ok != c.ok {
+			t.Errorf("comparing %v to %v: expected (%d, %v), got (%d, %v)", c.a, c.b, c.comparison, c.ok, comparison, ok)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package gedcom
+
+import (
+	"fmt"
+)
+
+// Severity indicates how severe an issue described by a diagnostic is
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Diagnostic describes an issue found, and possibly repaired, while validating a gedcom
+type Diagnostic struct {
+	Rule     string   `json:"Rule"`
+	Severity Severity `json:"Severity"`
+	XRefId   string   `json:"XRefId,omitempty"`
+	Line     int      `json:"Line,omitempty"`
+	Message  string   `json:"Message"`
+}
+
+func newDiagnostic(rule string, severity Severity, xRefId string, format string, args ...interface{}) *Diagnostic {
+	return &Diagnostic{
+		Rule:     rule,
+		Severity: severity,
+		XRefId:   xRefId,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func (d *Diagnostic) String() string {
+	location := ""
+	if d.Line > 0 {
+		location += fmt.Sprintf(" line %d", d.Line)
+	}
+	if d.XRefId != "" {
+		location += " " + d.XRefId
+	}
+	return fmt.Sprintf("%s [%s]%s: %s", d.Severity, d.Rule, location, d.Message)
+}
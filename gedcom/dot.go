@@ -0,0 +1,211 @@
+package gedcom
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GraphDirection determines which relatives of the root individual a graph holds
+type GraphDirection string
+
+const (
+	// GraphAncestorsAndDescendants holds both the ancestors and the descendants of the root individual
+	GraphAncestorsAndDescendants GraphDirection = ""
+	// GraphAncestors only holds the ancestors of the root individual
+	GraphAncestors GraphDirection = "ancestors"
+	// GraphDescendants only holds the descendants of the root individual, along with their spouses
+	GraphDescendants GraphDirection = "descendants"
+)
+
+// ParseGraphDirection parses a graph direction, where an empty value or both graphs ancestors and descendants
+func ParseGraphDirection(value string) (GraphDirection, error) {
+	switch GraphDirection(value) {
+	case GraphAncestorsAndDescendants, "both":
+		return GraphAncestorsAndDescendants, nil
+	case GraphAncestors:
+		return GraphAncestors, nil
+	case GraphDescendants:
+		return GraphDescendants, nil
+	}
+	return GraphAncestorsAndDescendants, fmt.Errorf("invalid graph direction %s, expected one of: both|ancestors|descendants", value)
+}
+
+// GraphOptions determine which part of a gedcom ToDot renders and how
+type GraphOptions struct {
+	// RootId is the xRefId of the individual the graph starts from, graphs without a root individual hold every individual
+	RootId string
+	// Depth is the amount of generations from the root individual the graph holds, 0 holds all generations
+	Depth int
+	// Direction determines whether the graph holds the ancestors and/or descendants of the root individual
+	Direction GraphDirection
+	// ColorByGender fills the nodes of individuals with a colour per gender
+	ColorByGender bool
+}
+
+var graphColorsByGenders = map[string]string{
+	"MALE":   "lightblue",
+	"FEMALE": "pink",
+}
+
+const graphUnknownGenderColor = "lightgrey"
+
+// ToDot renders a gedcom as a GraphViz DOT graph. Individuals are nodes labelled with their name and life dates,
+// families are junction nodes to which spouses and from which children are connected.
+// The graph options of the export options determine which individuals the graph holds, nil options render all of them.
+func (g *ConcurrencySafeGedcom) ToDot(options *ExportOptions) (*[]byte, error) {
+	var graphOptions *GraphOptions
+	if options != nil {
+		graphOptions = options.Graph
+	}
+	exported := g.exportedGedcom(options)
+	graphed := &ConcurrencySafeGedcom{}
+	graphed.Individuals, graphed.Families = exported.Individuals, exported.Families
+	individuals := graphed.IndividualsByIds()
+	families := graphed.FamiliesByIds()
+
+	includedIndividuals, includedFamilies, err := graphedRecords(individuals, families, graphOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	buf.WriteString("digraph gedcom {\n")
+	buf.WriteString("\trankdir=TB;\n")
+	buf.WriteString("\tnode [shape=box, style=filled, fillcolor=white];\n")
+	buf.WriteString("\tedge [arrowhead=none];\n")
+	for _, id := range sortedKeys(includedIndividuals) {
+		i := individuals[id]
+		attributes := fmt.Sprintf("label=%s", dotString(individualLabel(id, i)))
+		if graphOptions != nil && graphOptions.ColorByGender {
+			color, ok := graphColorsByGenders[i.GetGender()]
+			if !ok {
+				color = graphUnknownGenderColor
+			}
+			attributes += fmt.Sprintf(", fillcolor=%s", dotString(color))
+		}
+		buf.WriteString(fmt.Sprintf("\t%s [%s];\n", dotString(id), attributes))
+	}
+	for _, id := range sortedKeys(includedFamilies) {
+		f := families[id]
+		buf.WriteString(fmt.Sprintf("\t%s [shape=point, label=\"\"];\n", dotString(id)))
+		for _, spouseId := range []string{f.FatherId, f.MotherId} {
+			if includedIndividuals[spouseId] {
+				buf.WriteString(fmt.Sprintf("\t%s -> %s;\n", dotString(spouseId), dotString(id)))
+			}
+		}
+		for _, childId := range f.ChildIds {
+			if includedIndividuals[childId] {
+				buf.WriteString(fmt.Sprintf("\t%s -> %s [arrowhead=normal];\n", dotString(id), dotString(childId)))
+			}
+		}
+	}
+	buf.WriteString("}\n")
+
+	dot := buf.Bytes()
+	return &dot, nil
+}
+
+// graphedRecords determines the ids of the individuals and families a graph holds
+func graphedRecords(individuals map[string]*Gedcom_Individual, families map[string]*Gedcom_Family, options *GraphOptions) (map[string]bool, map[string]bool, error) {
+	includedIndividuals, includedFamilies := map[string]bool{}, map[string]bool{}
+	if options == nil || options.RootId == "" {
+		for id := range individuals {
+			includedIndividuals[id] = true
+		}
+		for id := range families {
+			includedFamilies[id] = true
+		}
+		return includedIndividuals, includedFamilies, nil
+	}
+	if _, ok := individuals[options.RootId]; !ok {
+		return nil, nil, fmt.Errorf("root individual %s doesn't exist", options.RootId)
+	}
+
+	familyList := make([]*Gedcom_Family, 0, len(families))
+	for _, f := range families {
+		familyList = append(familyList, f)
+	}
+	sort.Slice(familyList, func(i, j int) bool {
+		return familyList[i].Id < familyList[j].Id
+	})
+	maxGenerations := options.Depth
+	if maxGenerations <= 0 {
+		maxGenerations = len(individuals)
+	}
+	lineage := map[string]bool{options.RootId: true}
+	descendants := map[string]bool{options.RootId: true}
+	if options.Direction != GraphDescendants {
+		forEachRelative(options.RootId, parentIdsByIndividualIds(familyList), maxGenerations, func(relativeId string, _ int) {
+			lineage[relativeId] = true
+		})
+	}
+	if options.Direction != GraphAncestors {
+		forEachRelative(options.RootId, childIdsByIndividualIds(familyList), maxGenerations, func(relativeId string, _ int) {
+			lineage[relativeId] = true
+			descendants[relativeId] = true
+		})
+	}
+
+	for id := range lineage {
+		includedIndividuals[id] = true
+	}
+	for _, f := range familyList {
+		spouseInLineage := lineage[f.FatherId] || lineage[f.MotherId]
+		spouseIsDescendant := descendants[f.FatherId] || descendants[f.MotherId]
+		childInLineage := false
+		for _, childId := range f.ChildIds {
+			childInLineage = childInLineage || lineage[childId]
+		}
+		// families connect generations of the lineage, descendants are graphed along with their spouses unless their
+		// children are beyond the depth of the graph
+		if spouseInLineage && childInLineage || options.Direction != GraphAncestors && spouseIsDescendant && len(f.ChildIds) == 0 {
+			includedFamilies[f.Id] = true
+			for _, spouseId := range []string{f.FatherId, f.MotherId} {
+				if _, ok := individuals[spouseId]; ok {
+					includedIndividuals[spouseId] = true
+				}
+			}
+		}
+	}
+	return includedIndividuals, includedFamilies, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// individualLabel labels an individual with their first name and the years of their birth and death, if known
+func individualLabel(id string, i *Gedcom_Individual) string {
+	name := id
+	if len(i.GetNames()) > 0 {
+		if fullName := strings.TrimSpace(i.Names[0].GivenName + " " + i.Names[0].Surname); fullName != "" {
+			name = fullName
+		}
+	}
+	birthYear, birthKnown := earliestEventYear(i.GetBirthEvents())
+	deathYear, deathKnown := earliestEventYear(i.GetDeathEvents())
+	switch {
+	case birthKnown && deathKnown:
+		return fmt.Sprintf("%s\n%d - %d", name, birthYear, deathYear)
+	case birthKnown:
+		return fmt.Sprintf("%s\n* %d", name, birthYear)
+	case deathKnown:
+		return fmt.Sprintf("%s\n† %d", name, deathYear)
+	}
+	return name
+}
+
+// dotString quotes a string as a DOT identifier
+func dotString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return `"` + value + `"`
+}
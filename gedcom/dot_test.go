@@ -0,0 +1,138 @@
+package gedcom
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var dotTestLines = []string{
+	"0 HEAD",
+	"0 @I1@ INDI",
+	"1 NAME John /Smith/",
+	"1 SEX M",
+	"1 BIRT",
+	"2 DATE 1 JAN 1900",
+	"1 DEAT",
+	"2 DATE 1980",
+	"1 FAMS @F1@",
+	"0 @I2@ INDI",
+	"1 NAME Mary /Jones/",
+	"1 SEX F",
+	"1 FAMS @F1@",
+	"0 @I3@ INDI",
+	"1 NAME Jim \"Jimmy\" /Smith/",
+	"1 BIRT",
+	"2 DATE 1925",
+	"1 FAMC @F1@",
+	"1 FAMS @F2@",
+	"0 @I4@ INDI",
+	"1 NAME Anne /Brown/",
+	"1 SEX F",
+	"1 FAMS @F2@",
+	"0 @I5@ INDI",
+	"1 NAME Tom /Smith/",
+	"1 FAMC @F2@",
+	"0 @F1@ FAM",
+	"1 HUSB @I1@",
+	"1 WIFE @I2@",
+	"1 CHIL @I3@",
+	"0 @F2@ FAM",
+	"1 HUSB @I3@",
+	"1 WIFE @I4@",
+	"1 CHIL @I5@",
+	"0 TRLR",
+}
+
+var dotNodePattern = regexp.MustCompile(`(?m)^\t"(@[^"]+@)" \[`)
+
+// dotNodes returns the sorted ids of the nodes of a DOT graph
+func dotNodes(dot string) []string {
+	nodes := []string{}
+	for _, match := range dotNodePattern.FindAllStringSubmatch(dot, -1) {
+		nodes = append(nodes, match[1])
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+func TestToDot(t *testing.T) {
+	g := interpretGedcomLines(dotTestLines)
+	dot, err := g.ToDot(&ExportOptions{Graph: &GraphOptions{ColorByGender: true}})
+	if err != nil {
+		t.Fatalf("failed to render DOT graph with error: %s", err)
+	}
+	result := string(*dot)
+	for _, expected := range []string{
+		"digraph gedcom {\n",
+		"\t\"@I1@\" [label=\"John Smith\\n1900 - 1980\", fillcolor=\"lightblue\"];\n",
+		"\t\"@I2@\" [label=\"Mary Jones\", fillcolor=\"pink\"];\n",
+		"\t\"@I3@\" [label=\"Jim \\\"Jimmy\\\" Smith\\n* 1925\", fillcolor=\"lightgrey\"];\n",
+		"\t\"@F1@\" [shape=point, label=\"\"];\n",
+		"\t\"@I1@\" -> \"@F1@\";\n",
+		"\t\"@I2@\" -> \"@F1@\";\n",
+		"\t\"@F1@\" -> \"@I3@\" [arrowhead=normal];\n",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expected graph to contain %q, found %s", expected, result)
+		}
+	}
+
+	withoutColors, err := g.ToDot(nil)
+	if err != nil {
+		t.Fatalf("failed to render DOT graph with error: %s", err)
+	}
+	if strings.Contains(string(*withoutColors), "lightblue") {
+		t.Errorf("expected graph without colours, found %s", *withoutColors)
+	}
+}
+
+func TestToDotFromRoot(t *testing.T) {
+	g := interpretGedcomLines(dotTestLines)
+	cases := []struct {
+		options  GraphOptions
+		expected []string
+	}{
+		{GraphOptions{RootId: "@I3@"}, []string{"@F1@", "@F2@", "@I1@", "@I2@", "@I3@", "@I4@", "@I5@"}},
+		{GraphOptions{RootId: "@I3@", Direction: GraphAncestors}, []string{"@F1@", "@I1@", "@I2@", "@I3@"}},
+		{GraphOptions{RootId: "@I3@", Direction: GraphDescendants}, []string{"@F2@", "@I3@", "@I4@", "@I5@"}},
+		{GraphOptions{RootId: "@I5@", Depth: 1}, []string{"@F2@", "@I3@", "@I4@", "@I5@"}},
+		{GraphOptions{RootId: "@I1@", Direction: GraphDescendants, Depth: 1}, []string{"@F1@", "@I1@", "@I2@", "@I3@"}},
+	}
+	for _, c := range cases {
+		options := c.options
+		dot, err := g.ToDot(&ExportOptions{Graph: &options})
+		if err != nil {
+			t.Fatalf("failed to render DOT graph with error: %s", err)
+		}
+		if actual := dotNodes(string(*dot)); !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%+v: expected nodes %v, found %v", c.options, c.expected, actual)
+		}
+	}
+
+	if _, err := g.ToDot(&ExportOptions{Graph: &GraphOptions{RootId: "@I9@"}}); err == nil {
+		t.Errorf("expected an error graphing from an individual that doesn't exist")
+	}
+}
+
+func TestParseGraphDirection(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected GraphDirection
+		valid    bool
+	}{
+		{"", GraphAncestorsAndDescendants, true},
+		{"both", GraphAncestorsAndDescendants, true},
+		{"ancestors", GraphAncestors, true},
+		{"descendants", GraphDescendants, true},
+		{"siblings", GraphAncestorsAndDescendants, false},
+	}
+	for _, c := range cases {
+		direction, err := ParseGraphDirection(c.value)
+		if direction != c.expected || (err == nil) != c.valid {
+			t.Errorf("ParseGraphDirection(%q) = %q, %v", c.value, direction, err)
+		}
+	}
+}
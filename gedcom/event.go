@@ -1,56 +1,124 @@
 package gedcom
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/jochenboesmans/gedcom-parser/util"
+	"log"
 )
 
+// IndividualEventType is a type of the events of individuals
+type IndividualEventType struct {
+	// Tag is the GEDCOM tag of the type, e.g. BIRT
+	Tag string
+	// Name is the name of the type, e.g. Birth, which is its GEDCOM X fact type as well
+	Name string
+	// Events returns the events of the type of an individual, which can be appended to
+	Events func(i *Gedcom_Individual) *[]*Gedcom_Individual_Event
+}
+
+// IndividualEventTypes are the types of the events of individuals the gedcom structure holds
+var IndividualEventTypes = []IndividualEventType{
+	{"BIRT", "Birth", func(i *Gedcom_Individual) *[]*Gedcom_Individual_Event { return &i.BirthEvents }},
+	{"DEAT", "Death", func(i *Gedcom_Individual) *[]*Gedcom_Individual_Event { return &i.DeathEvents }},
+	{"BURI", "Burial", func(i *Gedcom_Individual) *[]*Gedcom_Individual_Event { return &i.BurialEvents }},
+	{"RESI", "Residence", func(i *Gedcom_Individual) *[]*Gedcom_Individual_Event { return &i.Residences }},
+}
+
+// MarriageEventTag is the GEDCOM tag of the marriage events of families
+const MarriageEventTag = "MARR"
+
 type Event struct {
 	Date
 	Place
-	Primary bool
+	Primary     bool
+	Address     *Gedcom_Address
+	Restriction string
+	SortDate    *Date
+	Links       structureLinks
 }
 
 func interpretEventStructure(eventLines []*Line) (*Event, error) {
-	rootLevel, err := eventLines[0].Level()
+	_, err := eventLines[0].Level()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse root level of event structure: %s", err)
 	}
 
-	event := Event{}
-	for _, eventLine := range eventLines[1:] {
-		level, err := eventLine.Level()
-		if err != nil {
-			continue
-		}
-		if level <= rootLevel {
-			break // end of event structure
-		}
-
-		tag, err := eventLine.Tag()
-		if err != nil {
-			continue
-		}
+	event := Event{
+		Address: interpretAddressStructure(eventLines),
+		Links:   interpretStructureLinks(eventLines),
+	}
+	forEachSubordinateLine(eventLines, func(tag string, subordinateLines []*Line) {
 		switch tag {
 		case "DATE":
-			event.Date = interpretDateStructure(eventLine)
+			event.Date = interpretDatePhraseStructure(subordinateLines)
+		case "SDATE", "_SDATE":
+			sortDate := interpretDatePhraseStructure(subordinateLines)
+			event.SortDate = &sortDate
 		case "PLAC":
-			event.Place = Place(eventLine.Value())
+			event.Place = Place(subordinateLines[0].Value())
+		case "RESN":
+			event.Restriction = subordinateLines[0].Value()
 		case "_PRIM":
-			if primaryBool, ok := util.PrimaryBoolByValue[eventLine.Value()]; ok {
+			if primaryBool, ok := util.PrimaryBoolByValue[subordinateLines[0].Value()]; ok {
 				event.Primary = primaryBool
 			}
 		}
-	}
+	})
 	return &event, nil
 }
 
 func (event *Event) toGedcomIndividualEvent() Gedcom_Individual_Event {
 	gedcomIndividualDate := event.Date.toGedcomIndividualDate()
 	placeString := event.Place.toString()
+	var gedcomIndividualSortDate *Gedcom_Individual_Date
+	if event.SortDate != nil {
+		sortDate := event.SortDate.toGedcomIndividualDate()
+		gedcomIndividualSortDate = &sortDate
+	}
 	return Gedcom_Individual_Event{
-		Date:    &gedcomIndividualDate,
-		Place:   placeString,
-		Primary: event.Primary,
+		Date:            &gedcomIndividualDate,
+		Place:           placeString,
+		Primary:         event.Primary,
+		Address:         event.Address,
+		Restriction:     event.Restriction,
+		SortDate:        gedcomIndividualSortDate,
+		SourceCitations: event.Links.sourceCitations,
+		Notes:           event.Links.notes,
+		MultimediaLinks: event.Links.multimediaLinks,
+	}
+}
+
+// interpretNonEventStructure interprets a GEDCOM 7.0 NON_EVENT_STRUCTURE (NO),
+// asserting that an event didn't take place, optionally within a period of time
+func interpretNonEventStructure(nonEventLines []*Line) *Gedcom_NonEvent {
+	nonEvent := &Gedcom_NonEvent{
+		Event: nonEventLines[0].Value(),
+	}
+	forEachSubordinateLine(nonEventLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "DATE":
+			nonEvent.DatePeriod = subordinateLines[0].Value()
+		case "SOUR":
+			nonEvent.SourceCitations = append(nonEvent.SourceCitations, interpretSourceCitationStructure(subordinateLines))
+		case "NOTE", "SNOTE":
+			nonEvent.Notes = append(nonEvent.Notes, interpretNoteLinkStructure(subordinateLines))
+		}
+	})
+	return nonEvent
+}
+
+func createAndWriteNonEventLines(nonEvents []*Gedcom_NonEvent, nonEventLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, nonEvent := range nonEvents {
+		err := createAndWriteLine(nonEventLevel, "", "_NO", nonEvent.Event, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		createAndWriteTagValueLines(nonEventLevel+1, []tagValue{
+			{"DATE", nonEvent.DatePeriod},
+		}, lineCounter, buf)
+		createAndWriteSourceCitationLines(nonEvent.SourceCitations, nonEventLevel+1, lineCounter, buf)
+		createAndWriteNoteLinkLines(nonEvent.Notes, nonEventLevel+1, lineCounter, buf)
 	}
 }
@@ -0,0 +1,46 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+)
+
+// interpretFamilyLinkStructure interprets a CHILD_TO_FAMILY_LINK (FAMC) or SPOUSE_TO_FAMILY_LINK (FAMS)
+// pointing from an individual to a family they're a member of
+func interpretFamilyLinkStructure(familyLinkLines []*Line) *Gedcom_Individual_FamilyLink {
+	familyLink := &Gedcom_Individual_FamilyLink{
+		FamilyId: familyLinkLines[0].Value(),
+	}
+	forEachSubordinateLine(familyLinkLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "PEDI":
+			familyLink.Pedigree = subordinateLines[0].Value()
+		case "NOTE", "SNOTE":
+			familyLink.Notes = append(familyLink.Notes, interpretNoteLinkStructure(subordinateLines))
+		}
+	})
+	return familyLink
+}
+
+func createAndWriteFamilyLinkLines(tag string, familyLinks []*Gedcom_Individual_FamilyLink, familyLinkLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, familyLink := range familyLinks {
+		err := createAndWriteLine(familyLinkLevel, "", tag, familyLink.FamilyId, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		createAndWriteTagValueLines(familyLinkLevel+1, []tagValue{
+			{"PEDI", familyLink.Pedigree},
+		}, lineCounter, buf)
+		createAndWriteNoteLinkLines(familyLink.Notes, familyLinkLevel+1, lineCounter, buf)
+	}
+}
+
+// familyLinkPointers strips family links down to the families they point to
+func familyLinkPointers(familyLinks []*Gedcom_Individual_FamilyLink) []*Gedcom_Individual_FamilyLink {
+	var pointers []*Gedcom_Individual_FamilyLink
+	for _, familyLink := range familyLinks {
+		pointers = append(pointers, &Gedcom_Individual_FamilyLink{FamilyId: familyLink.FamilyId})
+	}
+	return pointers
+}
@@ -2,11 +2,16 @@ package gedcom
 
 import (
 	"sync"
+	"time"
 )
 
 type ConcurrencySafeGedcom struct {
 	Gedcom
 	rwlock sync.RWMutex
+	// issues found while reading the gedcom, reported along with those found by Validate
+	readDiagnostics []*Diagnostic
+	// the stream records are handed to as they're interpreted instead of being added to the gedcom, if any
+	stream *recordStream
 }
 
 func NewConcurrencySafeGedcom() *ConcurrencySafeGedcom {
@@ -39,3 +44,50 @@ func (g *ConcurrencySafeGedcom) FamiliesByIds() map[string]*Gedcom_Family {
 	}
 	return result
 }
+
+// RecordsChangedAfter returns a Gedcom holding the header and all records of which the change date (CHAN) lies after the given timestamp.
+// Records without a valid change date are left out.
+func (g *ConcurrencySafeGedcom) RecordsChangedAfter(timestamp time.Time) *Gedcom {
+	result := &Gedcom{
+		Header: g.Header,
+	}
+	for _, i := range g.Individuals {
+		if changedAfter(i.ChangeDate, timestamp) {
+			result.Individuals = append(result.Individuals, i)
+		}
+	}
+	for _, f := range g.Families {
+		if changedAfter(f.ChangeDate, timestamp) {
+			result.Families = append(result.Families, f)
+		}
+	}
+	for _, m := range g.Multimedias {
+		if changedAfter(m.ChangeDate, timestamp) {
+			result.Multimedias = append(result.Multimedias, m)
+		}
+	}
+	for _, n := range g.Notes {
+		if changedAfter(n.ChangeDate, timestamp) {
+			result.Notes = append(result.Notes, n)
+		}
+	}
+	for _, r := range g.Repositories {
+		if changedAfter(r.ChangeDate, timestamp) {
+			result.Repositories = append(result.Repositories, r)
+		}
+	}
+	for _, s := range g.Submitters {
+		if changedAfter(s.ChangeDate, timestamp) {
+			result.Submitters = append(result.Submitters, s)
+		}
+	}
+	for _, s := range g.Sources {
+		if changedAfter(s.ChangeDate, timestamp) {
+			result.Sources = append(result.Sources, s)
+		}
+	}
+	if changedAfter(g.Submission.GetChangeDate(), timestamp) {
+		result.Submission = g.Submission
+	}
+	return result
+}
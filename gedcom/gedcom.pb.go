@@ -30,14 +30,15 @@ type Gedcom struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header       *Gedcom_HeaderType   `protobuf:"bytes,1,opt,name=Header,proto3" json:"Header,omitempty"`
-	Individuals  []*Gedcom_Individual `protobuf:"bytes,2,rep,name=Individuals,proto3" json:"Individuals,omitempty"`
-	Families     []*Gedcom_Family     `protobuf:"bytes,3,rep,name=Families,proto3" json:"Families,omitempty"`
-	Multimedias  []*Gedcom_Multimedia `protobuf:"bytes,4,rep,name=Multimedias,proto3" json:"Multimedias,omitempty"`
-	Notes        []*Gedcom_Note       `protobuf:"bytes,5,rep,name=Notes,proto3" json:"Notes,omitempty"`
-	Repositories []*Gedcom_Repository `protobuf:"bytes,6,rep,name=Repositories,proto3" json:"Repositories,omitempty"`
-	Submitters   []*Gedcom_Submitter  `protobuf:"bytes,7,rep,name=Submitters,proto3" json:"Submitters,omitempty"`
-	Sources      []*Gedcom_Source     `protobuf:"bytes,8,rep,name=Sources,proto3" json:"Sources,omitempty"`
+	Header       *Gedcom_HeaderType     `protobuf:"bytes,1,opt,name=Header,proto3" json:"Header,omitempty"`
+	Individuals  []*Gedcom_Individual   `protobuf:"bytes,2,rep,name=Individuals,proto3" json:"Individuals,omitempty"`
+	Families     []*Gedcom_Family       `protobuf:"bytes,3,rep,name=Families,proto3" json:"Families,omitempty"`
+	Multimedias  []*Gedcom_Multimedia   `protobuf:"bytes,4,rep,name=Multimedias,proto3" json:"Multimedias,omitempty"`
+	Notes        []*Gedcom_Note         `protobuf:"bytes,5,rep,name=Notes,proto3" json:"Notes,omitempty"`
+	Repositories []*Gedcom_Repository   `protobuf:"bytes,6,rep,name=Repositories,proto3" json:"Repositories,omitempty"`
+	Submitters   []*Gedcom_Submitter    `protobuf:"bytes,7,rep,name=Submitters,proto3" json:"Submitters,omitempty"`
+	Sources      []*Gedcom_Source       `protobuf:"bytes,8,rep,name=Sources,proto3" json:"Sources,omitempty"`
+	Submission   *Gedcom_SubmissionType `protobuf:"bytes,9,opt,name=Submission,proto3" json:"Submission,omitempty"`
 }
 
 func (x *Gedcom) Reset() {
@@ -128,15 +129,33 @@ func (x *Gedcom) GetSources() []*Gedcom_Source {
 	return nil
 }
 
+func (x *Gedcom) GetSubmission() *Gedcom_SubmissionType {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
 type Gedcom_HeaderType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source         string                                `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
-	Submitter      string                                `protobuf:"bytes,2,opt,name=Submitter,proto3" json:"Submitter,omitempty"`
-	GedcomMetaData *Gedcom_HeaderType_GedcomMetaDataType `protobuf:"bytes,3,opt,name=GedcomMetaData,proto3" json:"GedcomMetaData,omitempty"`
-	CharacterSet   string                                `protobuf:"bytes,4,opt,name=CharacterSet,proto3" json:"CharacterSet,omitempty"`
+	Source           string                                `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
+	Submitter        string                                `protobuf:"bytes,2,opt,name=Submitter,proto3" json:"Submitter,omitempty"`
+	GedcomMetaData   *Gedcom_HeaderType_GedcomMetaDataType `protobuf:"bytes,3,opt,name=GedcomMetaData,proto3" json:"GedcomMetaData,omitempty"`
+	CharacterSet     string                                `protobuf:"bytes,4,opt,name=CharacterSet,proto3" json:"CharacterSet,omitempty"`
+	SourceSystem     *Gedcom_HeaderType_SourceSystemType   `protobuf:"bytes,5,opt,name=SourceSystem,proto3" json:"SourceSystem,omitempty"`
+	Destination      string                                `protobuf:"bytes,6,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	TransmissionDate *Gedcom_Individual_Date               `protobuf:"bytes,7,opt,name=TransmissionDate,proto3" json:"TransmissionDate,omitempty"`
+	TransmissionTime string                                `protobuf:"bytes,8,opt,name=TransmissionTime,proto3" json:"TransmissionTime,omitempty"`
+	Submission       string                                `protobuf:"bytes,9,opt,name=Submission,proto3" json:"Submission,omitempty"`
+	FileName         string                                `protobuf:"bytes,10,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	Copyright        string                                `protobuf:"bytes,11,opt,name=Copyright,proto3" json:"Copyright,omitempty"`
+	Language         string                                `protobuf:"bytes,12,opt,name=Language,proto3" json:"Language,omitempty"`
+	PlaceForm        string                                `protobuf:"bytes,13,opt,name=PlaceForm,proto3" json:"PlaceForm,omitempty"`
+	Note             string                                `protobuf:"bytes,14,opt,name=Note,proto3" json:"Note,omitempty"`
+	ExtensionTags    []*Gedcom_HeaderType_ExtensionTag     `protobuf:"bytes,15,rep,name=ExtensionTags,proto3" json:"ExtensionTags,omitempty"`
 }
 
 func (x *Gedcom_HeaderType) Reset() {
@@ -199,16 +218,113 @@ func (x *Gedcom_HeaderType) GetCharacterSet() string {
 	return ""
 }
 
+func (x *Gedcom_HeaderType) GetSourceSystem() *Gedcom_HeaderType_SourceSystemType {
+	if x != nil {
+		return x.SourceSystem
+	}
+	return nil
+}
+
+func (x *Gedcom_HeaderType) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetTransmissionDate() *Gedcom_Individual_Date {
+	if x != nil {
+		return x.TransmissionDate
+	}
+	return nil
+}
+
+func (x *Gedcom_HeaderType) GetTransmissionTime() string {
+	if x != nil {
+		return x.TransmissionTime
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetSubmission() string {
+	if x != nil {
+		return x.Submission
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetCopyright() string {
+	if x != nil {
+		return x.Copyright
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetPlaceForm() string {
+	if x != nil {
+		return x.PlaceForm
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType) GetExtensionTags() []*Gedcom_HeaderType_ExtensionTag {
+	if x != nil {
+		return x.ExtensionTags
+	}
+	return nil
+}
+
 type Gedcom_Individual struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                     `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Names       []*Gedcom_Individual_Name  `protobuf:"bytes,2,rep,name=Names,proto3" json:"Names,omitempty"`
-	Gender      string                     `protobuf:"bytes,3,opt,name=Gender,proto3" json:"Gender,omitempty"`
-	BirthEvents []*Gedcom_Individual_Event `protobuf:"bytes,4,rep,name=BirthEvents,proto3" json:"BirthEvents,omitempty"`
-	DeathEvents []*Gedcom_Individual_Event `protobuf:"bytes,5,rep,name=DeathEvents,proto3" json:"DeathEvents,omitempty"`
+	Id                  string                           `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Names               []*Gedcom_Individual_Name        `protobuf:"bytes,2,rep,name=Names,proto3" json:"Names,omitempty"`
+	Gender              string                           `protobuf:"bytes,3,opt,name=Gender,proto3" json:"Gender,omitempty"`
+	BirthEvents         []*Gedcom_Individual_Event       `protobuf:"bytes,4,rep,name=BirthEvents,proto3" json:"BirthEvents,omitempty"`
+	DeathEvents         []*Gedcom_Individual_Event       `protobuf:"bytes,5,rep,name=DeathEvents,proto3" json:"DeathEvents,omitempty"`
+	Residences          []*Gedcom_Individual_Event       `protobuf:"bytes,6,rep,name=Residences,proto3" json:"Residences,omitempty"`
+	UserReferences      []*Gedcom_UserReference          `protobuf:"bytes,7,rep,name=UserReferences,proto3" json:"UserReferences,omitempty"`
+	AutomatedRecordId   string                           `protobuf:"bytes,8,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	UniqueIds           []string                         `protobuf:"bytes,9,rep,name=UniqueIds,proto3" json:"UniqueIds,omitempty"`
+	ChangeDate          *Gedcom_ChangeDate               `protobuf:"bytes,10,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+	Associations        []*Gedcom_Individual_Association `protobuf:"bytes,11,rep,name=Associations,proto3" json:"Associations,omitempty"`
+	Aliases             []string                         `protobuf:"bytes,12,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
+	LdsBaptisms         []*Gedcom_LdsOrdinance           `protobuf:"bytes,13,rep,name=LdsBaptisms,proto3" json:"LdsBaptisms,omitempty"`
+	LdsConfirmations    []*Gedcom_LdsOrdinance           `protobuf:"bytes,14,rep,name=LdsConfirmations,proto3" json:"LdsConfirmations,omitempty"`
+	LdsEndowments       []*Gedcom_LdsOrdinance           `protobuf:"bytes,15,rep,name=LdsEndowments,proto3" json:"LdsEndowments,omitempty"`
+	LdsChildSealings    []*Gedcom_LdsOrdinance           `protobuf:"bytes,16,rep,name=LdsChildSealings,proto3" json:"LdsChildSealings,omitempty"`
+	Restriction         string                           `protobuf:"bytes,17,opt,name=Restriction,proto3" json:"Restriction,omitempty"`
+	ChildToFamilyLinks  []*Gedcom_Individual_FamilyLink  `protobuf:"bytes,18,rep,name=ChildToFamilyLinks,proto3" json:"ChildToFamilyLinks,omitempty"`
+	SpouseToFamilyLinks []*Gedcom_Individual_FamilyLink  `protobuf:"bytes,19,rep,name=SpouseToFamilyLinks,proto3" json:"SpouseToFamilyLinks,omitempty"`
+	BurialEvents        []*Gedcom_Individual_Event       `protobuf:"bytes,20,rep,name=BurialEvents,proto3" json:"BurialEvents,omitempty"`
+	NonEvents           []*Gedcom_NonEvent               `protobuf:"bytes,21,rep,name=NonEvents,proto3" json:"NonEvents,omitempty"`
+	ExternalIds         []*Gedcom_ExternalId             `protobuf:"bytes,22,rep,name=ExternalIds,proto3" json:"ExternalIds,omitempty"`
+	SourceCitations     []*Gedcom_SourceCitation         `protobuf:"bytes,23,rep,name=SourceCitations,proto3" json:"SourceCitations,omitempty"`
+	Notes               []*Gedcom_NoteLink               `protobuf:"bytes,24,rep,name=Notes,proto3" json:"Notes,omitempty"`
+	MultimediaLinks     []*Gedcom_MultimediaLink         `protobuf:"bytes,25,rep,name=MultimediaLinks,proto3" json:"MultimediaLinks,omitempty"`
 }
 
 func (x *Gedcom_Individual) Reset() {
@@ -278,15 +394,167 @@ func (x *Gedcom_Individual) GetDeathEvents() []*Gedcom_Individual_Event {
 	return nil
 }
 
+func (x *Gedcom_Individual) GetResidences() []*Gedcom_Individual_Event {
+	if x != nil {
+		return x.Residences
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetUserReferences() []*Gedcom_UserReference {
+	if x != nil {
+		return x.UserReferences
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_Individual) GetUniqueIds() []string {
+	if x != nil {
+		return x.UniqueIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetAssociations() []*Gedcom_Individual_Association {
+	if x != nil {
+		return x.Associations
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetLdsBaptisms() []*Gedcom_LdsOrdinance {
+	if x != nil {
+		return x.LdsBaptisms
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetLdsConfirmations() []*Gedcom_LdsOrdinance {
+	if x != nil {
+		return x.LdsConfirmations
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetLdsEndowments() []*Gedcom_LdsOrdinance {
+	if x != nil {
+		return x.LdsEndowments
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetLdsChildSealings() []*Gedcom_LdsOrdinance {
+	if x != nil {
+		return x.LdsChildSealings
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetRestriction() string {
+	if x != nil {
+		return x.Restriction
+	}
+	return ""
+}
+
+func (x *Gedcom_Individual) GetChildToFamilyLinks() []*Gedcom_Individual_FamilyLink {
+	if x != nil {
+		return x.ChildToFamilyLinks
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetSpouseToFamilyLinks() []*Gedcom_Individual_FamilyLink {
+	if x != nil {
+		return x.SpouseToFamilyLinks
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetBurialEvents() []*Gedcom_Individual_Event {
+	if x != nil {
+		return x.BurialEvents
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetNonEvents() []*Gedcom_NonEvent {
+	if x != nil {
+		return x.NonEvents
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetExternalIds() []*Gedcom_ExternalId {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetSourceCitations() []*Gedcom_SourceCitation {
+	if x != nil {
+		return x.SourceCitations
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Gedcom_Individual) GetMultimediaLinks() []*Gedcom_MultimediaLink {
+	if x != nil {
+		return x.MultimediaLinks
+	}
+	return nil
+}
+
 type Gedcom_Family struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	FatherId string   `protobuf:"bytes,2,opt,name=FatherId,proto3" json:"FatherId,omitempty"`
-	MotherId string   `protobuf:"bytes,3,opt,name=MotherId,proto3" json:"MotherId,omitempty"`
-	ChildIds []string `protobuf:"bytes,4,rep,name=ChildIds,proto3" json:"ChildIds,omitempty"`
+	Id                string                     `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	FatherId          string                     `protobuf:"bytes,2,opt,name=FatherId,proto3" json:"FatherId,omitempty"`
+	MotherId          string                     `protobuf:"bytes,3,opt,name=MotherId,proto3" json:"MotherId,omitempty"`
+	ChildIds          []string                   `protobuf:"bytes,4,rep,name=ChildIds,proto3" json:"ChildIds,omitempty"`
+	UserReferences    []*Gedcom_UserReference    `protobuf:"bytes,5,rep,name=UserReferences,proto3" json:"UserReferences,omitempty"`
+	AutomatedRecordId string                     `protobuf:"bytes,6,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	UniqueIds         []string                   `protobuf:"bytes,7,rep,name=UniqueIds,proto3" json:"UniqueIds,omitempty"`
+	ChangeDate        *Gedcom_ChangeDate         `protobuf:"bytes,8,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+	LdsSpouseSealings []*Gedcom_LdsOrdinance     `protobuf:"bytes,9,rep,name=LdsSpouseSealings,proto3" json:"LdsSpouseSealings,omitempty"`
+	Restriction       string                     `protobuf:"bytes,10,opt,name=Restriction,proto3" json:"Restriction,omitempty"`
+	MarriageEvents    []*Gedcom_Individual_Event `protobuf:"bytes,11,rep,name=MarriageEvents,proto3" json:"MarriageEvents,omitempty"`
+	NonEvents         []*Gedcom_NonEvent         `protobuf:"bytes,12,rep,name=NonEvents,proto3" json:"NonEvents,omitempty"`
+	ExternalIds       []*Gedcom_ExternalId       `protobuf:"bytes,13,rep,name=ExternalIds,proto3" json:"ExternalIds,omitempty"`
+	SourceCitations   []*Gedcom_SourceCitation   `protobuf:"bytes,14,rep,name=SourceCitations,proto3" json:"SourceCitations,omitempty"`
+	Notes             []*Gedcom_NoteLink         `protobuf:"bytes,15,rep,name=Notes,proto3" json:"Notes,omitempty"`
+	MultimediaLinks   []*Gedcom_MultimediaLink   `protobuf:"bytes,16,rep,name=MultimediaLinks,proto3" json:"MultimediaLinks,omitempty"`
 }
 
 func (x *Gedcom_Family) Reset() {
@@ -349,13 +617,104 @@ func (x *Gedcom_Family) GetChildIds() []string {
 	return nil
 }
 
+func (x *Gedcom_Family) GetUserReferences() []*Gedcom_UserReference {
+	if x != nil {
+		return x.UserReferences
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_Family) GetUniqueIds() []string {
+	if x != nil {
+		return x.UniqueIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetLdsSpouseSealings() []*Gedcom_LdsOrdinance {
+	if x != nil {
+		return x.LdsSpouseSealings
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetRestriction() string {
+	if x != nil {
+		return x.Restriction
+	}
+	return ""
+}
+
+func (x *Gedcom_Family) GetMarriageEvents() []*Gedcom_Individual_Event {
+	if x != nil {
+		return x.MarriageEvents
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetNonEvents() []*Gedcom_NonEvent {
+	if x != nil {
+		return x.NonEvents
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetExternalIds() []*Gedcom_ExternalId {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetSourceCitations() []*Gedcom_SourceCitation {
+	if x != nil {
+		return x.SourceCitations
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Gedcom_Family) GetMultimediaLinks() []*Gedcom_MultimediaLink {
+	if x != nil {
+		return x.MultimediaLinks
+	}
+	return nil
+}
+
 type Gedcom_Multimedia struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string                    `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Files []*Gedcom_Multimedia_File `protobuf:"bytes,2,rep,name=Files,proto3" json:"Files,omitempty"`
+	Id                string                    `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Files             []*Gedcom_Multimedia_File `protobuf:"bytes,2,rep,name=Files,proto3" json:"Files,omitempty"`
+	UserReferences    []*Gedcom_UserReference   `protobuf:"bytes,3,rep,name=UserReferences,proto3" json:"UserReferences,omitempty"`
+	AutomatedRecordId string                    `protobuf:"bytes,4,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	UniqueIds         []string                  `protobuf:"bytes,5,rep,name=UniqueIds,proto3" json:"UniqueIds,omitempty"`
+	ChangeDate        *Gedcom_ChangeDate        `protobuf:"bytes,6,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+	ExternalIds       []*Gedcom_ExternalId      `protobuf:"bytes,7,rep,name=ExternalIds,proto3" json:"ExternalIds,omitempty"`
+	Notes             []*Gedcom_NoteLink        `protobuf:"bytes,8,rep,name=Notes,proto3" json:"Notes,omitempty"`
+	SourceCitations   []*Gedcom_SourceCitation  `protobuf:"bytes,9,rep,name=SourceCitations,proto3" json:"SourceCitations,omitempty"`
 }
 
 func (x *Gedcom_Multimedia) Reset() {
@@ -404,32 +763,1718 @@ func (x *Gedcom_Multimedia) GetFiles() []*Gedcom_Multimedia_File {
 	return nil
 }
 
-type Gedcom_Note struct {
+func (x *Gedcom_Multimedia) GetUserReferences() []*Gedcom_UserReference {
+	if x != nil {
+		return x.UserReferences
+	}
+	return nil
+}
+
+func (x *Gedcom_Multimedia) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_Multimedia) GetUniqueIds() []string {
+	if x != nil {
+		return x.UniqueIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Multimedia) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_Multimedia) GetExternalIds() []*Gedcom_ExternalId {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Multimedia) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Gedcom_Multimedia) GetSourceCitations() []*Gedcom_SourceCitation {
+	if x != nil {
+		return x.SourceCitations
+	}
+	return nil
+}
+
+type Gedcom_Note struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                  `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	SubmitterText     string                  `protobuf:"bytes,2,opt,name=SubmitterText,proto3" json:"SubmitterText,omitempty"`
+	UserReferences    []*Gedcom_UserReference `protobuf:"bytes,3,rep,name=UserReferences,proto3" json:"UserReferences,omitempty"`
+	AutomatedRecordId string                  `protobuf:"bytes,4,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	UniqueIds         []string                `protobuf:"bytes,5,rep,name=UniqueIds,proto3" json:"UniqueIds,omitempty"`
+	ChangeDate        *Gedcom_ChangeDate      `protobuf:"bytes,6,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+	Translations      []*Gedcom_Translation   `protobuf:"bytes,7,rep,name=Translations,proto3" json:"Translations,omitempty"`
+	ExternalIds       []*Gedcom_ExternalId    `protobuf:"bytes,8,rep,name=ExternalIds,proto3" json:"ExternalIds,omitempty"`
+}
+
+func (x *Gedcom_Note) Reset() {
+	*x = Gedcom_Note{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_Note) ProtoMessage() {}
+
+func (x *Gedcom_Note) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_Note.ProtoReflect.Descriptor instead.
+func (*Gedcom_Note) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Gedcom_Note) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Gedcom_Note) GetSubmitterText() string {
+	if x != nil {
+		return x.SubmitterText
+	}
+	return ""
+}
+
+func (x *Gedcom_Note) GetUserReferences() []*Gedcom_UserReference {
+	if x != nil {
+		return x.UserReferences
+	}
+	return nil
+}
+
+func (x *Gedcom_Note) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_Note) GetUniqueIds() []string {
+	if x != nil {
+		return x.UniqueIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Note) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_Note) GetTranslations() []*Gedcom_Translation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+func (x *Gedcom_Note) GetExternalIds() []*Gedcom_ExternalId {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+type Gedcom_Repository struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                  `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name              string                  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address           *Gedcom_Address         `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	UserReferences    []*Gedcom_UserReference `protobuf:"bytes,4,rep,name=UserReferences,proto3" json:"UserReferences,omitempty"`
+	AutomatedRecordId string                  `protobuf:"bytes,5,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	UniqueIds         []string                `protobuf:"bytes,6,rep,name=UniqueIds,proto3" json:"UniqueIds,omitempty"`
+	ChangeDate        *Gedcom_ChangeDate      `protobuf:"bytes,7,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+	ExternalIds       []*Gedcom_ExternalId    `protobuf:"bytes,8,rep,name=ExternalIds,proto3" json:"ExternalIds,omitempty"`
+	Notes             []*Gedcom_NoteLink      `protobuf:"bytes,9,rep,name=Notes,proto3" json:"Notes,omitempty"`
+}
+
+func (x *Gedcom_Repository) Reset() {
+	*x = Gedcom_Repository{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_Repository) ProtoMessage() {}
+
+func (x *Gedcom_Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_Repository.ProtoReflect.Descriptor instead.
+func (*Gedcom_Repository) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *Gedcom_Repository) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Gedcom_Repository) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Gedcom_Repository) GetAddress() *Gedcom_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Gedcom_Repository) GetUserReferences() []*Gedcom_UserReference {
+	if x != nil {
+		return x.UserReferences
+	}
+	return nil
+}
+
+func (x *Gedcom_Repository) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_Repository) GetUniqueIds() []string {
+	if x != nil {
+		return x.UniqueIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Repository) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_Repository) GetExternalIds() []*Gedcom_ExternalId {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Repository) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Gedcom_Source struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                       `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	UserReferences      []*Gedcom_UserReference      `protobuf:"bytes,2,rep,name=UserReferences,proto3" json:"UserReferences,omitempty"`
+	AutomatedRecordId   string                       `protobuf:"bytes,3,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	UniqueIds           []string                     `protobuf:"bytes,4,rep,name=UniqueIds,proto3" json:"UniqueIds,omitempty"`
+	ChangeDate          *Gedcom_ChangeDate           `protobuf:"bytes,5,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+	RepositoryCitations []*Gedcom_RepositoryCitation `protobuf:"bytes,6,rep,name=RepositoryCitations,proto3" json:"RepositoryCitations,omitempty"`
+	ExternalIds         []*Gedcom_ExternalId         `protobuf:"bytes,7,rep,name=ExternalIds,proto3" json:"ExternalIds,omitempty"`
+	Author              string                       `protobuf:"bytes,8,opt,name=Author,proto3" json:"Author,omitempty"`
+	Title               string                       `protobuf:"bytes,9,opt,name=Title,proto3" json:"Title,omitempty"`
+	Publication         string                       `protobuf:"bytes,10,opt,name=Publication,proto3" json:"Publication,omitempty"`
+	Notes               []*Gedcom_NoteLink           `protobuf:"bytes,11,rep,name=Notes,proto3" json:"Notes,omitempty"`
+	MultimediaLinks     []*Gedcom_MultimediaLink     `protobuf:"bytes,12,rep,name=MultimediaLinks,proto3" json:"MultimediaLinks,omitempty"`
+}
+
+func (x *Gedcom_Source) Reset() {
+	*x = Gedcom_Source{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_Source) ProtoMessage() {}
+
+func (x *Gedcom_Source) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_Source.ProtoReflect.Descriptor instead.
+func (*Gedcom_Source) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *Gedcom_Source) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Gedcom_Source) GetUserReferences() []*Gedcom_UserReference {
+	if x != nil {
+		return x.UserReferences
+	}
+	return nil
+}
+
+func (x *Gedcom_Source) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_Source) GetUniqueIds() []string {
+	if x != nil {
+		return x.UniqueIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Source) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_Source) GetRepositoryCitations() []*Gedcom_RepositoryCitation {
+	if x != nil {
+		return x.RepositoryCitations
+	}
+	return nil
+}
+
+func (x *Gedcom_Source) GetExternalIds() []*Gedcom_ExternalId {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Source) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Gedcom_Source) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Gedcom_Source) GetPublication() string {
+	if x != nil {
+		return x.Publication
+	}
+	return ""
+}
+
+func (x *Gedcom_Source) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Gedcom_Source) GetMultimediaLinks() []*Gedcom_MultimediaLink {
+	if x != nil {
+		return x.MultimediaLinks
+	}
+	return nil
+}
+
+type Gedcom_Submitter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string                   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name                      string                   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address                   *Gedcom_Address          `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	Languages                 []string                 `protobuf:"bytes,4,rep,name=Languages,proto3" json:"Languages,omitempty"`
+	MultimediaLinks           []*Gedcom_MultimediaLink `protobuf:"bytes,5,rep,name=MultimediaLinks,proto3" json:"MultimediaLinks,omitempty"`
+	RegisteredReferenceNumber string                   `protobuf:"bytes,6,opt,name=RegisteredReferenceNumber,proto3" json:"RegisteredReferenceNumber,omitempty"`
+	AutomatedRecordId         string                   `protobuf:"bytes,7,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	ChangeDate                *Gedcom_ChangeDate       `protobuf:"bytes,8,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+	UserReferences            []*Gedcom_UserReference  `protobuf:"bytes,9,rep,name=UserReferences,proto3" json:"UserReferences,omitempty"`
+	UniqueIds                 []string                 `protobuf:"bytes,10,rep,name=UniqueIds,proto3" json:"UniqueIds,omitempty"`
+	ExternalIds               []*Gedcom_ExternalId     `protobuf:"bytes,11,rep,name=ExternalIds,proto3" json:"ExternalIds,omitempty"`
+}
+
+func (x *Gedcom_Submitter) Reset() {
+	*x = Gedcom_Submitter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_Submitter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_Submitter) ProtoMessage() {}
+
+func (x *Gedcom_Submitter) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_Submitter.ProtoReflect.Descriptor instead.
+func (*Gedcom_Submitter) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *Gedcom_Submitter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Gedcom_Submitter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Gedcom_Submitter) GetAddress() *Gedcom_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Gedcom_Submitter) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *Gedcom_Submitter) GetMultimediaLinks() []*Gedcom_MultimediaLink {
+	if x != nil {
+		return x.MultimediaLinks
+	}
+	return nil
+}
+
+func (x *Gedcom_Submitter) GetRegisteredReferenceNumber() string {
+	if x != nil {
+		return x.RegisteredReferenceNumber
+	}
+	return ""
+}
+
+func (x *Gedcom_Submitter) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_Submitter) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_Submitter) GetUserReferences() []*Gedcom_UserReference {
+	if x != nil {
+		return x.UserReferences
+	}
+	return nil
+}
+
+func (x *Gedcom_Submitter) GetUniqueIds() []string {
+	if x != nil {
+		return x.UniqueIds
+	}
+	return nil
+}
+
+func (x *Gedcom_Submitter) GetExternalIds() []*Gedcom_ExternalId {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+type Gedcom_SubmissionType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       string             `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	SubmitterId              string             `protobuf:"bytes,2,opt,name=SubmitterId,proto3" json:"SubmitterId,omitempty"`
+	FamilyFileName           string             `protobuf:"bytes,3,opt,name=FamilyFileName,proto3" json:"FamilyFileName,omitempty"`
+	TempleCode               string             `protobuf:"bytes,4,opt,name=TempleCode,proto3" json:"TempleCode,omitempty"`
+	GenerationsOfAncestors   string             `protobuf:"bytes,5,opt,name=GenerationsOfAncestors,proto3" json:"GenerationsOfAncestors,omitempty"`
+	GenerationsOfDescendants string             `protobuf:"bytes,6,opt,name=GenerationsOfDescendants,proto3" json:"GenerationsOfDescendants,omitempty"`
+	OrdinanceProcessFlag     string             `protobuf:"bytes,7,opt,name=OrdinanceProcessFlag,proto3" json:"OrdinanceProcessFlag,omitempty"`
+	AutomatedRecordId        string             `protobuf:"bytes,8,opt,name=AutomatedRecordId,proto3" json:"AutomatedRecordId,omitempty"`
+	ChangeDate               *Gedcom_ChangeDate `protobuf:"bytes,9,opt,name=ChangeDate,proto3" json:"ChangeDate,omitempty"`
+}
+
+func (x *Gedcom_SubmissionType) Reset() {
+	*x = Gedcom_SubmissionType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_SubmissionType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_SubmissionType) ProtoMessage() {}
+
+func (x *Gedcom_SubmissionType) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_SubmissionType.ProtoReflect.Descriptor instead.
+func (*Gedcom_SubmissionType) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 8}
+}
+
+func (x *Gedcom_SubmissionType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetSubmitterId() string {
+	if x != nil {
+		return x.SubmitterId
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetFamilyFileName() string {
+	if x != nil {
+		return x.FamilyFileName
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetTempleCode() string {
+	if x != nil {
+		return x.TempleCode
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetGenerationsOfAncestors() string {
+	if x != nil {
+		return x.GenerationsOfAncestors
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetGenerationsOfDescendants() string {
+	if x != nil {
+		return x.GenerationsOfDescendants
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetOrdinanceProcessFlag() string {
+	if x != nil {
+		return x.OrdinanceProcessFlag
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetAutomatedRecordId() string {
+	if x != nil {
+		return x.AutomatedRecordId
+	}
+	return ""
+}
+
+func (x *Gedcom_SubmissionType) GetChangeDate() *Gedcom_ChangeDate {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return nil
+}
+
+type Gedcom_MultimediaLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MultimediaId string                          `protobuf:"bytes,1,opt,name=MultimediaId,proto3" json:"MultimediaId,omitempty"`
+	Files        []*Gedcom_Multimedia_File       `protobuf:"bytes,2,rep,name=Files,proto3" json:"Files,omitempty"`
+	Title        string                          `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Crop         *Gedcom_MultimediaLink_CropType `protobuf:"bytes,4,opt,name=Crop,proto3" json:"Crop,omitempty"`
+}
+
+func (x *Gedcom_MultimediaLink) Reset() {
+	*x = Gedcom_MultimediaLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_MultimediaLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_MultimediaLink) ProtoMessage() {}
+
+func (x *Gedcom_MultimediaLink) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_MultimediaLink.ProtoReflect.Descriptor instead.
+func (*Gedcom_MultimediaLink) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *Gedcom_MultimediaLink) GetMultimediaId() string {
+	if x != nil {
+		return x.MultimediaId
+	}
+	return ""
+}
+
+func (x *Gedcom_MultimediaLink) GetFiles() []*Gedcom_Multimedia_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Gedcom_MultimediaLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Gedcom_MultimediaLink) GetCrop() *Gedcom_MultimediaLink_CropType {
+	if x != nil {
+		return x.Crop
+	}
+	return nil
+}
+
+type Gedcom_NoteLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NoteId        string                `protobuf:"bytes,1,opt,name=NoteId,proto3" json:"NoteId,omitempty"`
+	SubmitterText string                `protobuf:"bytes,2,opt,name=SubmitterText,proto3" json:"SubmitterText,omitempty"`
+	Translations  []*Gedcom_Translation `protobuf:"bytes,3,rep,name=Translations,proto3" json:"Translations,omitempty"`
+}
+
+func (x *Gedcom_NoteLink) Reset() {
+	*x = Gedcom_NoteLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_NoteLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_NoteLink) ProtoMessage() {}
+
+func (x *Gedcom_NoteLink) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_NoteLink.ProtoReflect.Descriptor instead.
+func (*Gedcom_NoteLink) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 10}
+}
+
+func (x *Gedcom_NoteLink) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *Gedcom_NoteLink) GetSubmitterText() string {
+	if x != nil {
+		return x.SubmitterText
+	}
+	return ""
+}
+
+func (x *Gedcom_NoteLink) GetTranslations() []*Gedcom_Translation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type Gedcom_SourceCitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceId    string             `protobuf:"bytes,1,opt,name=SourceId,proto3" json:"SourceId,omitempty"`
+	Description string             `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Page        string             `protobuf:"bytes,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	Quality     string             `protobuf:"bytes,4,opt,name=Quality,proto3" json:"Quality,omitempty"`
+	Notes       []*Gedcom_NoteLink `protobuf:"bytes,5,rep,name=Notes,proto3" json:"Notes,omitempty"`
+}
+
+func (x *Gedcom_SourceCitation) Reset() {
+	*x = Gedcom_SourceCitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_SourceCitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_SourceCitation) ProtoMessage() {}
+
+func (x *Gedcom_SourceCitation) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_SourceCitation.ProtoReflect.Descriptor instead.
+func (*Gedcom_SourceCitation) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 11}
+}
+
+func (x *Gedcom_SourceCitation) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *Gedcom_SourceCitation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Gedcom_SourceCitation) GetPage() string {
+	if x != nil {
+		return x.Page
+	}
+	return ""
+}
+
+func (x *Gedcom_SourceCitation) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *Gedcom_SourceCitation) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Gedcom_RepositoryCitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepositoryId string             `protobuf:"bytes,1,opt,name=RepositoryId,proto3" json:"RepositoryId,omitempty"`
+	CallNumbers  []string           `protobuf:"bytes,2,rep,name=CallNumbers,proto3" json:"CallNumbers,omitempty"`
+	Notes        []*Gedcom_NoteLink `protobuf:"bytes,3,rep,name=Notes,proto3" json:"Notes,omitempty"`
+}
+
+func (x *Gedcom_RepositoryCitation) Reset() {
+	*x = Gedcom_RepositoryCitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_RepositoryCitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_RepositoryCitation) ProtoMessage() {}
+
+func (x *Gedcom_RepositoryCitation) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_RepositoryCitation.ProtoReflect.Descriptor instead.
+func (*Gedcom_RepositoryCitation) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 12}
+}
+
+func (x *Gedcom_RepositoryCitation) GetRepositoryId() string {
+	if x != nil {
+		return x.RepositoryId
+	}
+	return ""
+}
+
+func (x *Gedcom_RepositoryCitation) GetCallNumbers() []string {
+	if x != nil {
+		return x.CallNumbers
+	}
+	return nil
+}
+
+func (x *Gedcom_RepositoryCitation) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Gedcom_LdsOrdinance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status           string                   `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	StatusChangeDate *Gedcom_Individual_Date  `protobuf:"bytes,2,opt,name=StatusChangeDate,proto3" json:"StatusChangeDate,omitempty"`
+	Date             *Gedcom_Individual_Date  `protobuf:"bytes,3,opt,name=Date,proto3" json:"Date,omitempty"`
+	TempleCode       string                   `protobuf:"bytes,4,opt,name=TempleCode,proto3" json:"TempleCode,omitempty"`
+	Place            string                   `protobuf:"bytes,5,opt,name=Place,proto3" json:"Place,omitempty"`
+	FamilyId         string                   `protobuf:"bytes,6,opt,name=FamilyId,proto3" json:"FamilyId,omitempty"`
+	SourceCitations  []*Gedcom_SourceCitation `protobuf:"bytes,7,rep,name=SourceCitations,proto3" json:"SourceCitations,omitempty"`
+	Notes            []*Gedcom_NoteLink       `protobuf:"bytes,8,rep,name=Notes,proto3" json:"Notes,omitempty"`
+}
+
+func (x *Gedcom_LdsOrdinance) Reset() {
+	*x = Gedcom_LdsOrdinance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_LdsOrdinance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_LdsOrdinance) ProtoMessage() {}
+
+func (x *Gedcom_LdsOrdinance) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_LdsOrdinance.ProtoReflect.Descriptor instead.
+func (*Gedcom_LdsOrdinance) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 13}
+}
+
+func (x *Gedcom_LdsOrdinance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Gedcom_LdsOrdinance) GetStatusChangeDate() *Gedcom_Individual_Date {
+	if x != nil {
+		return x.StatusChangeDate
+	}
+	return nil
+}
+
+func (x *Gedcom_LdsOrdinance) GetDate() *Gedcom_Individual_Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Gedcom_LdsOrdinance) GetTempleCode() string {
+	if x != nil {
+		return x.TempleCode
+	}
+	return ""
+}
+
+func (x *Gedcom_LdsOrdinance) GetPlace() string {
+	if x != nil {
+		return x.Place
+	}
+	return ""
+}
+
+func (x *Gedcom_LdsOrdinance) GetFamilyId() string {
+	if x != nil {
+		return x.FamilyId
+	}
+	return ""
+}
+
+func (x *Gedcom_LdsOrdinance) GetSourceCitations() []*Gedcom_SourceCitation {
+	if x != nil {
+		return x.SourceCitations
+	}
+	return nil
+}
+
+func (x *Gedcom_LdsOrdinance) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Gedcom_NonEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event           string                   `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty"`
+	DatePeriod      string                   `protobuf:"bytes,2,opt,name=DatePeriod,proto3" json:"DatePeriod,omitempty"`
+	SourceCitations []*Gedcom_SourceCitation `protobuf:"bytes,3,rep,name=SourceCitations,proto3" json:"SourceCitations,omitempty"`
+	Notes           []*Gedcom_NoteLink       `protobuf:"bytes,4,rep,name=Notes,proto3" json:"Notes,omitempty"`
+}
+
+func (x *Gedcom_NonEvent) Reset() {
+	*x = Gedcom_NonEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_NonEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_NonEvent) ProtoMessage() {}
+
+func (x *Gedcom_NonEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_NonEvent.ProtoReflect.Descriptor instead.
+func (*Gedcom_NonEvent) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 14}
+}
+
+func (x *Gedcom_NonEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Gedcom_NonEvent) GetDatePeriod() string {
+	if x != nil {
+		return x.DatePeriod
+	}
+	return ""
+}
+
+func (x *Gedcom_NonEvent) GetSourceCitations() []*Gedcom_SourceCitation {
+	if x != nil {
+		return x.SourceCitations
+	}
+	return nil
+}
+
+func (x *Gedcom_NonEvent) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Gedcom_ExternalId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+}
+
+func (x *Gedcom_ExternalId) Reset() {
+	*x = Gedcom_ExternalId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_ExternalId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_ExternalId) ProtoMessage() {}
+
+func (x *Gedcom_ExternalId) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_ExternalId.ProtoReflect.Descriptor instead.
+func (*Gedcom_ExternalId) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 15}
+}
+
+func (x *Gedcom_ExternalId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Gedcom_ExternalId) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Gedcom_Translation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text      string `protobuf:"bytes,1,opt,name=Text,proto3" json:"Text,omitempty"`
+	Language  string `protobuf:"bytes,2,opt,name=Language,proto3" json:"Language,omitempty"`
+	MediaType string `protobuf:"bytes,3,opt,name=MediaType,proto3" json:"MediaType,omitempty"`
+}
+
+func (x *Gedcom_Translation) Reset() {
+	*x = Gedcom_Translation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_Translation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_Translation) ProtoMessage() {}
+
+func (x *Gedcom_Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_Translation.ProtoReflect.Descriptor instead.
+func (*Gedcom_Translation) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 16}
+}
+
+func (x *Gedcom_Translation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Gedcom_Translation) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Gedcom_Translation) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+type Gedcom_UserReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number string `protobuf:"bytes,1,opt,name=Number,proto3" json:"Number,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+}
+
+func (x *Gedcom_UserReference) Reset() {
+	*x = Gedcom_UserReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_UserReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_UserReference) ProtoMessage() {}
+
+func (x *Gedcom_UserReference) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_UserReference.ProtoReflect.Descriptor instead.
+func (*Gedcom_UserReference) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 17}
+}
+
+func (x *Gedcom_UserReference) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Gedcom_UserReference) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Gedcom_ChangeDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date *Gedcom_Individual_Date `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
+	Time string                  `protobuf:"bytes,2,opt,name=Time,proto3" json:"Time,omitempty"`
+}
+
+func (x *Gedcom_ChangeDate) Reset() {
+	*x = Gedcom_ChangeDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_ChangeDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_ChangeDate) ProtoMessage() {}
+
+func (x *Gedcom_ChangeDate) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_ChangeDate.ProtoReflect.Descriptor instead.
+func (*Gedcom_ChangeDate) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 18}
+}
+
+func (x *Gedcom_ChangeDate) GetDate() *Gedcom_Individual_Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Gedcom_ChangeDate) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type Gedcom_Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text           string   `protobuf:"bytes,1,opt,name=Text,proto3" json:"Text,omitempty"`
+	AddressLine1   string   `protobuf:"bytes,2,opt,name=AddressLine1,proto3" json:"AddressLine1,omitempty"`
+	AddressLine2   string   `protobuf:"bytes,3,opt,name=AddressLine2,proto3" json:"AddressLine2,omitempty"`
+	AddressLine3   string   `protobuf:"bytes,4,opt,name=AddressLine3,proto3" json:"AddressLine3,omitempty"`
+	City           string   `protobuf:"bytes,5,opt,name=City,proto3" json:"City,omitempty"`
+	State          string   `protobuf:"bytes,6,opt,name=State,proto3" json:"State,omitempty"`
+	PostalCode     string   `protobuf:"bytes,7,opt,name=PostalCode,proto3" json:"PostalCode,omitempty"`
+	Country        string   `protobuf:"bytes,8,opt,name=Country,proto3" json:"Country,omitempty"`
+	PhoneNumbers   []string `protobuf:"bytes,9,rep,name=PhoneNumbers,proto3" json:"PhoneNumbers,omitempty"`
+	EmailAddresses []string `protobuf:"bytes,10,rep,name=EmailAddresses,proto3" json:"EmailAddresses,omitempty"`
+	FaxNumbers     []string `protobuf:"bytes,11,rep,name=FaxNumbers,proto3" json:"FaxNumbers,omitempty"`
+	WebPages       []string `protobuf:"bytes,12,rep,name=WebPages,proto3" json:"WebPages,omitempty"`
+}
+
+func (x *Gedcom_Address) Reset() {
+	*x = Gedcom_Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_Address) ProtoMessage() {}
+
+func (x *Gedcom_Address) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_Address.ProtoReflect.Descriptor instead.
+func (*Gedcom_Address) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 19}
+}
+
+func (x *Gedcom_Address) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetAddressLine1() string {
+	if x != nil {
+		return x.AddressLine1
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetAddressLine2() string {
+	if x != nil {
+		return x.AddressLine2
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetAddressLine3() string {
+	if x != nil {
+		return x.AddressLine3
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Gedcom_Address) GetPhoneNumbers() []string {
+	if x != nil {
+		return x.PhoneNumbers
+	}
+	return nil
+}
+
+func (x *Gedcom_Address) GetEmailAddresses() []string {
+	if x != nil {
+		return x.EmailAddresses
+	}
+	return nil
+}
+
+func (x *Gedcom_Address) GetFaxNumbers() []string {
+	if x != nil {
+		return x.FaxNumbers
+	}
+	return nil
+}
+
+func (x *Gedcom_Address) GetWebPages() []string {
+	if x != nil {
+		return x.WebPages
+	}
+	return nil
+}
+
+type Gedcom_HeaderType_GedcomMetaDataType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionNumber string `protobuf:"bytes,1,opt,name=VersionNumber,proto3" json:"VersionNumber,omitempty"`
+	GedcomForm    string `protobuf:"bytes,2,opt,name=GedcomForm,proto3" json:"GedcomForm,omitempty"`
+}
+
+func (x *Gedcom_HeaderType_GedcomMetaDataType) Reset() {
+	*x = Gedcom_HeaderType_GedcomMetaDataType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_HeaderType_GedcomMetaDataType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_HeaderType_GedcomMetaDataType) ProtoMessage() {}
+
+func (x *Gedcom_HeaderType_GedcomMetaDataType) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_HeaderType_GedcomMetaDataType.ProtoReflect.Descriptor instead.
+func (*Gedcom_HeaderType_GedcomMetaDataType) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *Gedcom_HeaderType_GedcomMetaDataType) GetVersionNumber() string {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType_GedcomMetaDataType) GetGedcomForm() string {
+	if x != nil {
+		return x.GedcomForm
+	}
+	return ""
+}
+
+type Gedcom_HeaderType_SourceSystemType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version     string                                              `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	ProductName string                                              `protobuf:"bytes,2,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
+	Corporation *Gedcom_HeaderType_SourceSystemType_CorporationType `protobuf:"bytes,3,opt,name=Corporation,proto3" json:"Corporation,omitempty"`
+	Data        *Gedcom_HeaderType_SourceSystemType_DataType        `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType) Reset() {
+	*x = Gedcom_HeaderType_SourceSystemType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_HeaderType_SourceSystemType) ProtoMessage() {}
+
+func (x *Gedcom_HeaderType_SourceSystemType) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_HeaderType_SourceSystemType.ProtoReflect.Descriptor instead.
+func (*Gedcom_HeaderType_SourceSystemType) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType) GetCorporation() *Gedcom_HeaderType_SourceSystemType_CorporationType {
+	if x != nil {
+		return x.Corporation
+	}
+	return nil
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType) GetData() *Gedcom_HeaderType_SourceSystemType_DataType {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Gedcom_HeaderType_ExtensionTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	Uri string `protobuf:"bytes,2,opt,name=Uri,proto3" json:"Uri,omitempty"`
+}
+
+func (x *Gedcom_HeaderType_ExtensionTag) Reset() {
+	*x = Gedcom_HeaderType_ExtensionTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_HeaderType_ExtensionTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_HeaderType_ExtensionTag) ProtoMessage() {}
+
+func (x *Gedcom_HeaderType_ExtensionTag) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_HeaderType_ExtensionTag.ProtoReflect.Descriptor instead.
+func (*Gedcom_HeaderType_ExtensionTag) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *Gedcom_HeaderType_ExtensionTag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType_ExtensionTag) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type Gedcom_HeaderType_SourceSystemType_CorporationType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string          `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address *Gedcom_Address `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType_CorporationType) Reset() {
+	*x = Gedcom_HeaderType_SourceSystemType_CorporationType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gedcom_gedcom_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType_CorporationType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gedcom_HeaderType_SourceSystemType_CorporationType) ProtoMessage() {}
+
+func (x *Gedcom_HeaderType_SourceSystemType_CorporationType) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gedcom_HeaderType_SourceSystemType_CorporationType.ProtoReflect.Descriptor instead.
+func (*Gedcom_HeaderType_SourceSystemType_CorporationType) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 0, 1, 0}
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType_CorporationType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType_CorporationType) GetAddress() *Gedcom_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type Gedcom_HeaderType_SourceSystemType_DataType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	SubmitterText string `protobuf:"bytes,2,opt,name=SubmitterText,proto3" json:"SubmitterText,omitempty"`
+	Name      string                  `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Date      *Gedcom_Individual_Date `protobuf:"bytes,2,opt,name=Date,proto3" json:"Date,omitempty"`
+	Copyright string                  `protobuf:"bytes,3,opt,name=Copyright,proto3" json:"Copyright,omitempty"`
 }
 
-func (x *Gedcom_Note) Reset() {
-	*x = Gedcom_Note{}
+func (x *Gedcom_HeaderType_SourceSystemType_DataType) Reset() {
+	*x = Gedcom_HeaderType_SourceSystemType_DataType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[5]
+		mi := &file_gedcom_gedcom_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_Note) String() string {
+func (x *Gedcom_HeaderType_SourceSystemType_DataType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_Note) ProtoMessage() {}
+func (*Gedcom_HeaderType_SourceSystemType_DataType) ProtoMessage() {}
 
-func (x *Gedcom_Note) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[5]
+func (x *Gedcom_HeaderType_SourceSystemType_DataType) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,51 +2485,65 @@ func (x *Gedcom_Note) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gedcom_Note.ProtoReflect.Descriptor instead.
-func (*Gedcom_Note) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 4}
+// Deprecated: Use Gedcom_HeaderType_SourceSystemType_DataType.ProtoReflect.Descriptor instead.
+func (*Gedcom_HeaderType_SourceSystemType_DataType) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 0, 1, 1}
 }
 
-func (x *Gedcom_Note) GetId() string {
+func (x *Gedcom_HeaderType_SourceSystemType_DataType) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Gedcom_Note) GetSubmitterText() string {
+func (x *Gedcom_HeaderType_SourceSystemType_DataType) GetDate() *Gedcom_Individual_Date {
 	if x != nil {
-		return x.SubmitterText
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Gedcom_HeaderType_SourceSystemType_DataType) GetCopyright() string {
+	if x != nil {
+		return x.Copyright
 	}
 	return ""
 }
 
-type Gedcom_Repository struct {
+type Gedcom_Individual_Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Date            *Gedcom_Individual_Date  `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
+	Place           string                   `protobuf:"bytes,2,opt,name=Place,proto3" json:"Place,omitempty"`
+	Primary         bool                     `protobuf:"varint,3,opt,name=Primary,proto3" json:"Primary,omitempty"`
+	Address         *Gedcom_Address          `protobuf:"bytes,4,opt,name=Address,proto3" json:"Address,omitempty"`
+	Restriction     string                   `protobuf:"bytes,5,opt,name=Restriction,proto3" json:"Restriction,omitempty"`
+	SortDate        *Gedcom_Individual_Date  `protobuf:"bytes,6,opt,name=SortDate,proto3" json:"SortDate,omitempty"`
+	SourceCitations []*Gedcom_SourceCitation `protobuf:"bytes,7,rep,name=SourceCitations,proto3" json:"SourceCitations,omitempty"`
+	Notes           []*Gedcom_NoteLink       `protobuf:"bytes,8,rep,name=Notes,proto3" json:"Notes,omitempty"`
+	MultimediaLinks []*Gedcom_MultimediaLink `protobuf:"bytes,9,rep,name=MultimediaLinks,proto3" json:"MultimediaLinks,omitempty"`
 }
 
-func (x *Gedcom_Repository) Reset() {
-	*x = Gedcom_Repository{}
+func (x *Gedcom_Individual_Event) Reset() {
+	*x = Gedcom_Individual_Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[6]
+		mi := &file_gedcom_gedcom_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_Repository) String() string {
+func (x *Gedcom_Individual_Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_Repository) ProtoMessage() {}
+func (*Gedcom_Individual_Event) ProtoMessage() {}
 
-func (x *Gedcom_Repository) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[6]
+func (x *Gedcom_Individual_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,98 +2554,102 @@ func (x *Gedcom_Repository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gedcom_Repository.ProtoReflect.Descriptor instead.
-func (*Gedcom_Repository) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 5}
+// Deprecated: Use Gedcom_Individual_Event.ProtoReflect.Descriptor instead.
+func (*Gedcom_Individual_Event) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 0}
 }
 
-func (x *Gedcom_Repository) GetId() string {
+func (x *Gedcom_Individual_Event) GetDate() *Gedcom_Individual_Date {
 	if x != nil {
-		return x.Id
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-func (x *Gedcom_Repository) GetName() string {
+func (x *Gedcom_Individual_Event) GetPlace() string {
 	if x != nil {
-		return x.Name
+		return x.Place
 	}
 	return ""
 }
 
-type Gedcom_Source struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+func (x *Gedcom_Individual_Event) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
 }
 
-func (x *Gedcom_Source) Reset() {
-	*x = Gedcom_Source{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Gedcom_Individual_Event) GetAddress() *Gedcom_Address {
+	if x != nil {
+		return x.Address
 	}
+	return nil
 }
 
-func (x *Gedcom_Source) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Gedcom_Individual_Event) GetRestriction() string {
+	if x != nil {
+		return x.Restriction
+	}
+	return ""
 }
 
-func (*Gedcom_Source) ProtoMessage() {}
+func (x *Gedcom_Individual_Event) GetSortDate() *Gedcom_Individual_Date {
+	if x != nil {
+		return x.SortDate
+	}
+	return nil
+}
 
-func (x *Gedcom_Source) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Gedcom_Individual_Event) GetSourceCitations() []*Gedcom_SourceCitation {
+	if x != nil {
+		return x.SourceCitations
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Gedcom_Source.ProtoReflect.Descriptor instead.
-func (*Gedcom_Source) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 6}
+func (x *Gedcom_Individual_Event) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
 }
 
-func (x *Gedcom_Source) GetId() string {
+func (x *Gedcom_Individual_Event) GetMultimediaLinks() []*Gedcom_MultimediaLink {
 	if x != nil {
-		return x.Id
+		return x.MultimediaLinks
 	}
-	return ""
+	return nil
 }
 
-type Gedcom_Submitter struct {
+type Gedcom_Individual_Name struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	GivenName    string                `protobuf:"bytes,1,opt,name=GivenName,proto3" json:"GivenName,omitempty"`
+	Surname      string                `protobuf:"bytes,2,opt,name=Surname,proto3" json:"Surname,omitempty"`
+	Primary      bool                  `protobuf:"varint,3,opt,name=Primary,proto3" json:"Primary,omitempty"`
+	Translations []*Gedcom_Translation `protobuf:"bytes,4,rep,name=Translations,proto3" json:"Translations,omitempty"`
 }
 
-func (x *Gedcom_Submitter) Reset() {
-	*x = Gedcom_Submitter{}
+func (x *Gedcom_Individual_Name) Reset() {
+	*x = Gedcom_Individual_Name{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[8]
+		mi := &file_gedcom_gedcom_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_Submitter) String() string {
+func (x *Gedcom_Individual_Name) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_Submitter) ProtoMessage() {}
+func (*Gedcom_Individual_Name) ProtoMessage() {}
 
-func (x *Gedcom_Submitter) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[8]
+func (x *Gedcom_Individual_Name) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,51 +2660,67 @@ func (x *Gedcom_Submitter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gedcom_Submitter.ProtoReflect.Descriptor instead.
-func (*Gedcom_Submitter) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 7}
+// Deprecated: Use Gedcom_Individual_Name.ProtoReflect.Descriptor instead.
+func (*Gedcom_Individual_Name) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 1}
 }
 
-func (x *Gedcom_Submitter) GetId() string {
+func (x *Gedcom_Individual_Name) GetGivenName() string {
 	if x != nil {
-		return x.Id
+		return x.GivenName
 	}
 	return ""
 }
 
-func (x *Gedcom_Submitter) GetName() string {
+func (x *Gedcom_Individual_Name) GetSurname() string {
 	if x != nil {
-		return x.Name
+		return x.Surname
 	}
 	return ""
 }
 
-type Gedcom_HeaderType_GedcomMetaDataType struct {
+func (x *Gedcom_Individual_Name) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *Gedcom_Individual_Name) GetTranslations() []*Gedcom_Translation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type Gedcom_Individual_Date struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VersionNumber string `protobuf:"bytes,1,opt,name=VersionNumber,proto3" json:"VersionNumber,omitempty"`
-	GedcomForm    string `protobuf:"bytes,2,opt,name=GedcomForm,proto3" json:"GedcomForm,omitempty"`
+	Year   string `protobuf:"bytes,1,opt,name=Year,proto3" json:"Year,omitempty"`
+	Month  string `protobuf:"bytes,2,opt,name=Month,proto3" json:"Month,omitempty"`
+	Day    string `protobuf:"bytes,3,opt,name=Day,proto3" json:"Day,omitempty"`
+	Phrase string `protobuf:"bytes,4,opt,name=Phrase,proto3" json:"Phrase,omitempty"`
 }
 
-func (x *Gedcom_HeaderType_GedcomMetaDataType) Reset() {
-	*x = Gedcom_HeaderType_GedcomMetaDataType{}
+func (x *Gedcom_Individual_Date) Reset() {
+	*x = Gedcom_Individual_Date{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[9]
+		mi := &file_gedcom_gedcom_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_HeaderType_GedcomMetaDataType) String() string {
+func (x *Gedcom_Individual_Date) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_HeaderType_GedcomMetaDataType) ProtoMessage() {}
+func (*Gedcom_Individual_Date) ProtoMessage() {}
 
-func (x *Gedcom_HeaderType_GedcomMetaDataType) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[9]
+func (x *Gedcom_Individual_Date) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,52 +2731,67 @@ func (x *Gedcom_HeaderType_GedcomMetaDataType) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gedcom_HeaderType_GedcomMetaDataType.ProtoReflect.Descriptor instead.
-func (*Gedcom_HeaderType_GedcomMetaDataType) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 0, 0}
+// Deprecated: Use Gedcom_Individual_Date.ProtoReflect.Descriptor instead.
+func (*Gedcom_Individual_Date) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 2}
 }
 
-func (x *Gedcom_HeaderType_GedcomMetaDataType) GetVersionNumber() string {
+func (x *Gedcom_Individual_Date) GetYear() string {
 	if x != nil {
-		return x.VersionNumber
+		return x.Year
 	}
 	return ""
 }
 
-func (x *Gedcom_HeaderType_GedcomMetaDataType) GetGedcomForm() string {
+func (x *Gedcom_Individual_Date) GetMonth() string {
 	if x != nil {
-		return x.GedcomForm
+		return x.Month
 	}
 	return ""
 }
 
-type Gedcom_Individual_Event struct {
+func (x *Gedcom_Individual_Date) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *Gedcom_Individual_Date) GetPhrase() string {
+	if x != nil {
+		return x.Phrase
+	}
+	return ""
+}
+
+type Gedcom_Individual_Association struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Date    *Gedcom_Individual_Date `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
-	Place   string                  `protobuf:"bytes,2,opt,name=Place,proto3" json:"Place,omitempty"`
-	Primary bool                    `protobuf:"varint,3,opt,name=Primary,proto3" json:"Primary,omitempty"`
+	IndividualId    string                   `protobuf:"bytes,1,opt,name=IndividualId,proto3" json:"IndividualId,omitempty"`
+	Relation        string                   `protobuf:"bytes,2,opt,name=Relation,proto3" json:"Relation,omitempty"`
+	SourceCitations []*Gedcom_SourceCitation `protobuf:"bytes,3,rep,name=SourceCitations,proto3" json:"SourceCitations,omitempty"`
+	Notes           []*Gedcom_NoteLink       `protobuf:"bytes,4,rep,name=Notes,proto3" json:"Notes,omitempty"`
 }
 
-func (x *Gedcom_Individual_Event) Reset() {
-	*x = Gedcom_Individual_Event{}
+func (x *Gedcom_Individual_Association) Reset() {
+	*x = Gedcom_Individual_Association{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[10]
+		mi := &file_gedcom_gedcom_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_Individual_Event) String() string {
+func (x *Gedcom_Individual_Association) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_Individual_Event) ProtoMessage() {}
+func (*Gedcom_Individual_Association) ProtoMessage() {}
 
-func (x *Gedcom_Individual_Event) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[10]
+func (x *Gedcom_Individual_Association) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,59 +2802,66 @@ func (x *Gedcom_Individual_Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gedcom_Individual_Event.ProtoReflect.Descriptor instead.
-func (*Gedcom_Individual_Event) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 0}
+// Deprecated: Use Gedcom_Individual_Association.ProtoReflect.Descriptor instead.
+func (*Gedcom_Individual_Association) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 3}
 }
 
-func (x *Gedcom_Individual_Event) GetDate() *Gedcom_Individual_Date {
+func (x *Gedcom_Individual_Association) GetIndividualId() string {
 	if x != nil {
-		return x.Date
+		return x.IndividualId
 	}
-	return nil
+	return ""
 }
 
-func (x *Gedcom_Individual_Event) GetPlace() string {
+func (x *Gedcom_Individual_Association) GetRelation() string {
 	if x != nil {
-		return x.Place
+		return x.Relation
 	}
 	return ""
 }
 
-func (x *Gedcom_Individual_Event) GetPrimary() bool {
+func (x *Gedcom_Individual_Association) GetSourceCitations() []*Gedcom_SourceCitation {
 	if x != nil {
-		return x.Primary
+		return x.SourceCitations
 	}
-	return false
+	return nil
 }
 
-type Gedcom_Individual_Name struct {
+func (x *Gedcom_Individual_Association) GetNotes() []*Gedcom_NoteLink {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type Gedcom_Individual_FamilyLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GivenName string `protobuf:"bytes,1,opt,name=GivenName,proto3" json:"GivenName,omitempty"`
-	Surname   string `protobuf:"bytes,2,opt,name=Surname,proto3" json:"Surname,omitempty"`
-	Primary   bool   `protobuf:"varint,3,opt,name=Primary,proto3" json:"Primary,omitempty"`
+	FamilyId string             `protobuf:"bytes,1,opt,name=FamilyId,proto3" json:"FamilyId,omitempty"`
+	Pedigree string             `protobuf:"bytes,2,opt,name=Pedigree,proto3" json:"Pedigree,omitempty"`
+	Notes    []*Gedcom_NoteLink `protobuf:"bytes,3,rep,name=Notes,proto3" json:"Notes,omitempty"`
 }
 
-func (x *Gedcom_Individual_Name) Reset() {
-	*x = Gedcom_Individual_Name{}
+func (x *Gedcom_Individual_FamilyLink) Reset() {
+	*x = Gedcom_Individual_FamilyLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[11]
+		mi := &file_gedcom_gedcom_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_Individual_Name) String() string {
+func (x *Gedcom_Individual_FamilyLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_Individual_Name) ProtoMessage() {}
+func (*Gedcom_Individual_FamilyLink) ProtoMessage() {}
 
-func (x *Gedcom_Individual_Name) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[11]
+func (x *Gedcom_Individual_FamilyLink) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,121 +2872,115 @@ func (x *Gedcom_Individual_Name) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gedcom_Individual_Name.ProtoReflect.Descriptor instead.
-func (*Gedcom_Individual_Name) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 1}
+// Deprecated: Use Gedcom_Individual_FamilyLink.ProtoReflect.Descriptor instead.
+func (*Gedcom_Individual_FamilyLink) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 4}
 }
 
-func (x *Gedcom_Individual_Name) GetGivenName() string {
+func (x *Gedcom_Individual_FamilyLink) GetFamilyId() string {
 	if x != nil {
-		return x.GivenName
+		return x.FamilyId
 	}
 	return ""
 }
 
-func (x *Gedcom_Individual_Name) GetSurname() string {
+func (x *Gedcom_Individual_FamilyLink) GetPedigree() string {
 	if x != nil {
-		return x.Surname
+		return x.Pedigree
 	}
 	return ""
 }
 
-func (x *Gedcom_Individual_Name) GetPrimary() bool {
+func (x *Gedcom_Individual_FamilyLink) GetNotes() []*Gedcom_NoteLink {
 	if x != nil {
-		return x.Primary
+		return x.Notes
 	}
-	return false
+	return nil
 }
 
-type Gedcom_Individual_Date struct {
+type Gedcom_Multimedia_File struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Year  string `protobuf:"bytes,1,opt,name=Year,proto3" json:"Year,omitempty"`
-	Month string `protobuf:"bytes,2,opt,name=Month,proto3" json:"Month,omitempty"`
-	Day   string `protobuf:"bytes,3,opt,name=Day,proto3" json:"Day,omitempty"`
+	Reference string `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Format    string `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`
 }
 
-func (x *Gedcom_Individual_Date) Reset() {
-	*x = Gedcom_Individual_Date{}
+func (x *Gedcom_Multimedia_File) Reset() {
+	*x = Gedcom_Multimedia_File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[12]
+		mi := &file_gedcom_gedcom_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_Individual_Date) String() string {
+func (x *Gedcom_Multimedia_File) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_Individual_Date) ProtoMessage() {}
+func (*Gedcom_Multimedia_File) ProtoMessage() {}
 
-func (x *Gedcom_Individual_Date) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[12]
+func (x *Gedcom_Multimedia_File) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
 		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Gedcom_Individual_Date.ProtoReflect.Descriptor instead.
-func (*Gedcom_Individual_Date) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 1, 2}
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Gedcom_Individual_Date) GetYear() string {
-	if x != nil {
-		return x.Year
-	}
-	return ""
+// Deprecated: Use Gedcom_Multimedia_File.ProtoReflect.Descriptor instead.
+func (*Gedcom_Multimedia_File) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 3, 0}
 }
 
-func (x *Gedcom_Individual_Date) GetMonth() string {
+func (x *Gedcom_Multimedia_File) GetReference() string {
 	if x != nil {
-		return x.Month
+		return x.Reference
 	}
 	return ""
 }
 
-func (x *Gedcom_Individual_Date) GetDay() string {
+func (x *Gedcom_Multimedia_File) GetFormat() string {
 	if x != nil {
-		return x.Day
+		return x.Format
 	}
 	return ""
 }
 
-type Gedcom_Multimedia_File struct {
+type Gedcom_MultimediaLink_CropType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reference string `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
-	Format    string `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`
+	Top    int32 `protobuf:"varint,1,opt,name=Top,proto3" json:"Top,omitempty"`
+	Left   int32 `protobuf:"varint,2,opt,name=Left,proto3" json:"Left,omitempty"`
+	Height int32 `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	Width  int32 `protobuf:"varint,4,opt,name=Width,proto3" json:"Width,omitempty"`
 }
 
-func (x *Gedcom_Multimedia_File) Reset() {
-	*x = Gedcom_Multimedia_File{}
+func (x *Gedcom_MultimediaLink_CropType) Reset() {
+	*x = Gedcom_MultimediaLink_CropType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gedcom_gedcom_proto_msgTypes[13]
+		mi := &file_gedcom_gedcom_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Gedcom_Multimedia_File) String() string {
+func (x *Gedcom_MultimediaLink_CropType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Gedcom_Multimedia_File) ProtoMessage() {}
+func (*Gedcom_MultimediaLink_CropType) ProtoMessage() {}
 
-func (x *Gedcom_Multimedia_File) ProtoReflect() protoreflect.Message {
-	mi := &file_gedcom_gedcom_proto_msgTypes[13]
+func (x *Gedcom_MultimediaLink_CropType) ProtoReflect() protoreflect.Message {
+	mi := &file_gedcom_gedcom_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,30 +2991,44 @@ func (x *Gedcom_Multimedia_File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Gedcom_Multimedia_File.ProtoReflect.Descriptor instead.
-func (*Gedcom_Multimedia_File) Descriptor() ([]byte, []int) {
-	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 3, 0}
+// Deprecated: Use Gedcom_MultimediaLink_CropType.ProtoReflect.Descriptor instead.
+func (*Gedcom_MultimediaLink_CropType) Descriptor() ([]byte, []int) {
+	return file_gedcom_gedcom_proto_rawDescGZIP(), []int{0, 9, 0}
 }
 
-func (x *Gedcom_Multimedia_File) GetReference() string {
+func (x *Gedcom_MultimediaLink_CropType) GetTop() int32 {
 	if x != nil {
-		return x.Reference
+		return x.Top
 	}
-	return ""
+	return 0
 }
 
-func (x *Gedcom_Multimedia_File) GetFormat() string {
+func (x *Gedcom_MultimediaLink_CropType) GetLeft() int32 {
 	if x != nil {
-		return x.Format
+		return x.Left
 	}
-	return ""
+	return 0
+}
+
+func (x *Gedcom_MultimediaLink_CropType) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Gedcom_MultimediaLink_CropType) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
 }
 
 var File_gedcom_gedcom_proto protoreflect.FileDescriptor
 
 var file_gedcom_gedcom_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x22, 0x92, 0x0d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x22, 0xa7, 0x4d,
 	0x0a, 0x06, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x12, 0x31, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64,
 	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f,
 	0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54,
@@ -947,88 +3056,602 @@ var file_gedcom_gedcom_proto_rawDesc = []byte{
 	0x52, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x07,
 	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x98, 0x02,
-	0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x65, 0x64,
-	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d,
-	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72,
-	0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x1a, 0x5a, 0x0a, 0x12,
-	0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x64, 0x63,
-	0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x47, 0x65,
-	0x64, 0x63, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x1a, 0xfb, 0x03, 0x0a, 0x0a, 0x49, 0x6e, 0x64,
-	0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x3d, 0x0a,
+	0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xef, 0x09, 0x0a,
+	0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x4d,
+	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a,
+	0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d,
+	0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75,
+	0x61, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f, 0x74,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x4c, 0x0a,
+	0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65,
+	0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x5a, 0x0a, 0x12, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x1a, 0xc0, 0x03, 0x0a, 0x10, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x70, 0x6f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x43, 0x6f, 0x72, 0x70, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65,
+	0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x57, 0x0a, 0x0f, 0x43, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d,
+	0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x70, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
 	0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61,
-	0x6c, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0b, 0x42, 0x69, 0x72, 0x74, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x65, 0x64,
-	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76,
-	0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x42, 0x69, 0x72,
-	0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x44, 0x65, 0x61, 0x74,
-	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6c, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x32, 0x0a, 0x0c, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03,
+	0x55, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x72, 0x69, 0x1a, 0xb4,
+	0x13, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64,
+	0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0b, 0x42,
+	0x69, 0x72, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d,
+	0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x42, 0x69, 0x72, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x41,
+	0x0a, 0x0b, 0x44, 0x65, 0x61, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x44, 0x65, 0x61, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x49, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c,
+	0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x41, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x4c, 0x64, 0x73, 0x42, 0x61, 0x70, 0x74, 0x69,
+	0x73, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4c, 0x64, 0x73, 0x4f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x4c, 0x64, 0x73, 0x42, 0x61, 0x70, 0x74, 0x69,
+	0x73, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x4c, 0x64, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4c, 0x64,
+	0x73, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x4c, 0x64, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0d,
+	0x4c, 0x64, 0x73, 0x45, 0x6e, 0x64, 0x6f, 0x77, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x4c, 0x64, 0x73, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x0d, 0x4c, 0x64, 0x73, 0x45, 0x6e, 0x64, 0x6f, 0x77, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x47, 0x0a, 0x10, 0x4c, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4c, 0x64, 0x73, 0x4f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x4c, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x53, 0x65, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x12, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61,
+	0x6c, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x12, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x54, 0x6f, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x12, 0x56, 0x0a, 0x13, 0x53, 0x70, 0x6f, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e,
-	0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b,
-	0x44, 0x65, 0x61, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x6b, 0x0a, 0x05, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
-	0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x44, 0x61,
-	0x74, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x58, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x47, 0x69, 0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x69, 0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x1a, 0x42, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x59, 0x65,
-	0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4d,
-	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x44, 0x61, 0x79, 0x1a, 0x6c, 0x0a, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x13, 0x53, 0x70, 0x6f, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x42, 0x75, 0x72, 0x69,
+	0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49,
+	0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x0c, 0x42, 0x75, 0x72, 0x69, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a,
+	0x09, 0x4e, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d,
+	0x2e, 0x4e, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x4e, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x52, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x73, 0x12, 0x47, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x19, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x1a, 0xbc, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76,
+	0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x30, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61,
+	0x6c, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x47, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x1a, 0x98, 0x01, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x69,
+	0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47,
+	0x69, 0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5a, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10,
+	0x0a, 0x03, 0x44, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x44, 0x61, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x1a, 0xc5, 0x01, 0x0a, 0x0b, 0x41, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x69,
+	0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d,
+	0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x1a, 0x73, 0x0a, 0x0a, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x65,
+	0x64, 0x69, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x65,
+	0x64, 0x69, 0x67, 0x72, 0x65, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05,
+	0x4e, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0xa4, 0x06, 0x0a, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
 	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
 	0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x74, 0x68, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x08, 0x46, 0x61, 0x74, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
 	0x4d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x4d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x68, 0x69, 0x6c,
 	0x64, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x49, 0x64, 0x73, 0x1a, 0x90, 0x01, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
-	0x6f, 0x6d, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x04, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x3c, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12,
+	0x64, 0x49, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x49, 0x0a, 0x11, 0x4c, 0x64, 0x73, 0x53, 0x70, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x65,
+	0x61, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4c, 0x64, 0x73,
+	0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x4c, 0x64, 0x73, 0x53, 0x70,
+	0x6f, 0x75, 0x73, 0x65, 0x53, 0x65, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47,
+	0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61,
+	0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x4d, 0x61, 0x72, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x4e, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x09, 0x4e, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b,
+	0x0a, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x0b,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65,
+	0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0f, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x1a, 0x92, 0x04, 0x0a,
+	0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d,
+	0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x0b,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x4e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x3c, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x1a, 0x86, 0x03, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x44, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a,
+	0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x0b, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x1a, 0x9b, 0x03, 0x0a, 0x0a, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x44, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d,
+	0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x0b, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0xbf, 0x04, 0x0a, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65,
+	0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74,
+	0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x53, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x1a, 0x90, 0x04, 0x0a, 0x09, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65,
+	0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x3b, 0x0a, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x52, 0x0b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x1a, 0x9b, 0x03,
+	0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x46, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x66, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x3a, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4f, 0x66, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4f, 0x66, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x32,
+	0x0a, 0x14, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x4f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x41,
+	0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65,
+	0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x1a, 0x9c, 0x02, 0x0a, 0x0e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x22,
+	0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3a,
+	0x0a, 0x04, 0x43, 0x72, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x72, 0x6f, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x43, 0x72, 0x6f, 0x70, 0x1a, 0x5e, 0x0a, 0x08, 0x43, 0x72,
+	0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x6f, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x54, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x65, 0x66, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x1a, 0x88, 0x01, 0x0a, 0x08, 0x4e,
+	0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12,
 	0x24, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x54, 0x65, 0x78, 0x74, 0x1a, 0x30, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x18, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x64, 0x1a, 0x2f, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6a, 0x6f, 0x63, 0x68, 0x65, 0x6e, 0x62, 0x6f, 0x65, 0x73, 0x6d, 0x61, 0x6e, 0x73, 0x2f,
-	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2d, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2f, 0x67, 0x65,
-	0x64, 0x63, 0x6f, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x65,
+	0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xab, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x51, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x1a, 0x89, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x61, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e,
+	0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x1a,
+	0xf0, 0x02, 0x0a, 0x0c, 0x4c, 0x64, 0x73, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x10, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0f, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74,
+	0x65, 0x73, 0x1a, 0xb8, 0x01, 0x0a, 0x08, 0x4e, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x47, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d,
+	0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f,
+	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x30, 0x0a,
+	0x0a, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x1a,
+	0x5b, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65,
+	0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x54, 0x0a, 0x0a, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x1a,
+	0xf5, 0x02, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x6e, 0x65, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x6e, 0x65, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x32, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x33, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x33, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x22, 0x0a, 0x0c, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x46,
+	0x61, 0x78, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x46, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x57,
+	0x65, 0x62, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x57,
+	0x65, 0x62, 0x50, 0x61, 0x67, 0x65, 0x73, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x6f, 0x63, 0x68, 0x65, 0x6e, 0x62, 0x6f, 0x65, 0x73,
+	0x6d, 0x61, 0x6e, 0x73, 0x2f, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x2d, 0x70, 0x61, 0x72, 0x73,
+	0x65, 0x72, 0x2f, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1043,22 +3666,41 @@ func file_gedcom_gedcom_proto_rawDescGZIP() []byte {
 	return file_gedcom_gedcom_proto_rawDescData
 }
 
-var file_gedcom_gedcom_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_gedcom_gedcom_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_gedcom_gedcom_proto_goTypes = []interface{}{
-	(*Gedcom)(nil),                               // 0: gedcom.Gedcom
-	(*Gedcom_HeaderType)(nil),                    // 1: gedcom.Gedcom.HeaderType
-	(*Gedcom_Individual)(nil),                    // 2: gedcom.Gedcom.Individual
-	(*Gedcom_Family)(nil),                        // 3: gedcom.Gedcom.Family
-	(*Gedcom_Multimedia)(nil),                    // 4: gedcom.Gedcom.Multimedia
-	(*Gedcom_Note)(nil),                          // 5: gedcom.Gedcom.Note
-	(*Gedcom_Repository)(nil),                    // 6: gedcom.Gedcom.Repository
-	(*Gedcom_Source)(nil),                        // 7: gedcom.Gedcom.Source
-	(*Gedcom_Submitter)(nil),                     // 8: gedcom.Gedcom.Submitter
-	(*Gedcom_HeaderType_GedcomMetaDataType)(nil), // 9: gedcom.Gedcom.HeaderType.GedcomMetaDataType
-	(*Gedcom_Individual_Event)(nil),              // 10: gedcom.Gedcom.Individual.Event
-	(*Gedcom_Individual_Name)(nil),               // 11: gedcom.Gedcom.Individual.Name
-	(*Gedcom_Individual_Date)(nil),               // 12: gedcom.Gedcom.Individual.Date
-	(*Gedcom_Multimedia_File)(nil),               // 13: gedcom.Gedcom.Multimedia.File
+	(*Gedcom)(nil),                                             // 0: gedcom.Gedcom
+	(*Gedcom_HeaderType)(nil),                                  // 1: gedcom.Gedcom.HeaderType
+	(*Gedcom_Individual)(nil),                                  // 2: gedcom.Gedcom.Individual
+	(*Gedcom_Family)(nil),                                      // 3: gedcom.Gedcom.Family
+	(*Gedcom_Multimedia)(nil),                                  // 4: gedcom.Gedcom.Multimedia
+	(*Gedcom_Note)(nil),                                        // 5: gedcom.Gedcom.Note
+	(*Gedcom_Repository)(nil),                                  // 6: gedcom.Gedcom.Repository
+	(*Gedcom_Source)(nil),                                      // 7: gedcom.Gedcom.Source
+	(*Gedcom_Submitter)(nil),                                   // 8: gedcom.Gedcom.Submitter
+	(*Gedcom_SubmissionType)(nil),                              // 9: gedcom.Gedcom.SubmissionType
+	(*Gedcom_MultimediaLink)(nil),                              // 10: gedcom.Gedcom.MultimediaLink
+	(*Gedcom_NoteLink)(nil),                                    // 11: gedcom.Gedcom.NoteLink
+	(*Gedcom_SourceCitation)(nil),                              // 12: gedcom.Gedcom.SourceCitation
+	(*Gedcom_RepositoryCitation)(nil),                          // 13: gedcom.Gedcom.RepositoryCitation
+	(*Gedcom_LdsOrdinance)(nil),                                // 14: gedcom.Gedcom.LdsOrdinance
+	(*Gedcom_NonEvent)(nil),                                    // 15: gedcom.Gedcom.NonEvent
+	(*Gedcom_ExternalId)(nil),                                  // 16: gedcom.Gedcom.ExternalId
+	(*Gedcom_Translation)(nil),                                 // 17: gedcom.Gedcom.Translation
+	(*Gedcom_UserReference)(nil),                               // 18: gedcom.Gedcom.UserReference
+	(*Gedcom_ChangeDate)(nil),                                  // 19: gedcom.Gedcom.ChangeDate
+	(*Gedcom_Address)(nil),                                     // 20: gedcom.Gedcom.Address
+	(*Gedcom_HeaderType_GedcomMetaDataType)(nil),               // 21: gedcom.Gedcom.HeaderType.GedcomMetaDataType
+	(*Gedcom_HeaderType_SourceSystemType)(nil),                 // 22: gedcom.Gedcom.HeaderType.SourceSystemType
+	(*Gedcom_HeaderType_ExtensionTag)(nil),                     // 23: gedcom.Gedcom.HeaderType.ExtensionTag
+	(*Gedcom_HeaderType_SourceSystemType_CorporationType)(nil), // 24: gedcom.Gedcom.HeaderType.SourceSystemType.CorporationType
+	(*Gedcom_HeaderType_SourceSystemType_DataType)(nil),        // 25: gedcom.Gedcom.HeaderType.SourceSystemType.DataType
+	(*Gedcom_Individual_Event)(nil),                            // 26: gedcom.Gedcom.Individual.Event
+	(*Gedcom_Individual_Name)(nil),                             // 27: gedcom.Gedcom.Individual.Name
+	(*Gedcom_Individual_Date)(nil),                             // 28: gedcom.Gedcom.Individual.Date
+	(*Gedcom_Individual_Association)(nil),                      // 29: gedcom.Gedcom.Individual.Association
+	(*Gedcom_Individual_FamilyLink)(nil),                       // 30: gedcom.Gedcom.Individual.FamilyLink
+	(*Gedcom_Multimedia_File)(nil),                             // 31: gedcom.Gedcom.Multimedia.File
+	(*Gedcom_MultimediaLink_CropType)(nil),                     // 32: gedcom.Gedcom.MultimediaLink.CropType
 }
 var file_gedcom_gedcom_proto_depIdxs = []int32{
 	1,  // 0: gedcom.Gedcom.Header:type_name -> gedcom.Gedcom.HeaderType
@@ -1069,17 +3711,97 @@ var file_gedcom_gedcom_proto_depIdxs = []int32{
 	6,  // 5: gedcom.Gedcom.Repositories:type_name -> gedcom.Gedcom.Repository
 	8,  // 6: gedcom.Gedcom.Submitters:type_name -> gedcom.Gedcom.Submitter
 	7,  // 7: gedcom.Gedcom.Sources:type_name -> gedcom.Gedcom.Source
-	9,  // 8: gedcom.Gedcom.HeaderType.GedcomMetaData:type_name -> gedcom.Gedcom.HeaderType.GedcomMetaDataType
-	11, // 9: gedcom.Gedcom.Individual.Names:type_name -> gedcom.Gedcom.Individual.Name
-	10, // 10: gedcom.Gedcom.Individual.BirthEvents:type_name -> gedcom.Gedcom.Individual.Event
-	10, // 11: gedcom.Gedcom.Individual.DeathEvents:type_name -> gedcom.Gedcom.Individual.Event
-	13, // 12: gedcom.Gedcom.Multimedia.Files:type_name -> gedcom.Gedcom.Multimedia.File
-	12, // 13: gedcom.Gedcom.Individual.Event.Date:type_name -> gedcom.Gedcom.Individual.Date
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	9,  // 8: gedcom.Gedcom.Submission:type_name -> gedcom.Gedcom.SubmissionType
+	21, // 9: gedcom.Gedcom.HeaderType.GedcomMetaData:type_name -> gedcom.Gedcom.HeaderType.GedcomMetaDataType
+	22, // 10: gedcom.Gedcom.HeaderType.SourceSystem:type_name -> gedcom.Gedcom.HeaderType.SourceSystemType
+	28, // 11: gedcom.Gedcom.HeaderType.TransmissionDate:type_name -> gedcom.Gedcom.Individual.Date
+	23, // 12: gedcom.Gedcom.HeaderType.ExtensionTags:type_name -> gedcom.Gedcom.HeaderType.ExtensionTag
+	27, // 13: gedcom.Gedcom.Individual.Names:type_name -> gedcom.Gedcom.Individual.Name
+	26, // 14: gedcom.Gedcom.Individual.BirthEvents:type_name -> gedcom.Gedcom.Individual.Event
+	26, // 15: gedcom.Gedcom.Individual.DeathEvents:type_name -> gedcom.Gedcom.Individual.Event
+	26, // 16: gedcom.Gedcom.Individual.Residences:type_name -> gedcom.Gedcom.Individual.Event
+	18, // 17: gedcom.Gedcom.Individual.UserReferences:type_name -> gedcom.Gedcom.UserReference
+	19, // 18: gedcom.Gedcom.Individual.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	29, // 19: gedcom.Gedcom.Individual.Associations:type_name -> gedcom.Gedcom.Individual.Association
+	14, // 20: gedcom.Gedcom.Individual.LdsBaptisms:type_name -> gedcom.Gedcom.LdsOrdinance
+	14, // 21: gedcom.Gedcom.Individual.LdsConfirmations:type_name -> gedcom.Gedcom.LdsOrdinance
+	14, // 22: gedcom.Gedcom.Individual.LdsEndowments:type_name -> gedcom.Gedcom.LdsOrdinance
+	14, // 23: gedcom.Gedcom.Individual.LdsChildSealings:type_name -> gedcom.Gedcom.LdsOrdinance
+	30, // 24: gedcom.Gedcom.Individual.ChildToFamilyLinks:type_name -> gedcom.Gedcom.Individual.FamilyLink
+	30, // 25: gedcom.Gedcom.Individual.SpouseToFamilyLinks:type_name -> gedcom.Gedcom.Individual.FamilyLink
+	26, // 26: gedcom.Gedcom.Individual.BurialEvents:type_name -> gedcom.Gedcom.Individual.Event
+	15, // 27: gedcom.Gedcom.Individual.NonEvents:type_name -> gedcom.Gedcom.NonEvent
+	16, // 28: gedcom.Gedcom.Individual.ExternalIds:type_name -> gedcom.Gedcom.ExternalId
+	12, // 29: gedcom.Gedcom.Individual.SourceCitations:type_name -> gedcom.Gedcom.SourceCitation
+	11, // 30: gedcom.Gedcom.Individual.Notes:type_name -> gedcom.Gedcom.NoteLink
+	10, // 31: gedcom.Gedcom.Individual.MultimediaLinks:type_name -> gedcom.Gedcom.MultimediaLink
+	18, // 32: gedcom.Gedcom.Family.UserReferences:type_name -> gedcom.Gedcom.UserReference
+	19, // 33: gedcom.Gedcom.Family.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	14, // 34: gedcom.Gedcom.Family.LdsSpouseSealings:type_name -> gedcom.Gedcom.LdsOrdinance
+	26, // 35: gedcom.Gedcom.Family.MarriageEvents:type_name -> gedcom.Gedcom.Individual.Event
+	15, // 36: gedcom.Gedcom.Family.NonEvents:type_name -> gedcom.Gedcom.NonEvent
+	16, // 37: gedcom.Gedcom.Family.ExternalIds:type_name -> gedcom.Gedcom.ExternalId
+	12, // 38: gedcom.Gedcom.Family.SourceCitations:type_name -> gedcom.Gedcom.SourceCitation
+	11, // 39: gedcom.Gedcom.Family.Notes:type_name -> gedcom.Gedcom.NoteLink
+	10, // 40: gedcom.Gedcom.Family.MultimediaLinks:type_name -> gedcom.Gedcom.MultimediaLink
+	31, // 41: gedcom.Gedcom.Multimedia.Files:type_name -> gedcom.Gedcom.Multimedia.File
+	18, // 42: gedcom.Gedcom.Multimedia.UserReferences:type_name -> gedcom.Gedcom.UserReference
+	19, // 43: gedcom.Gedcom.Multimedia.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	16, // 44: gedcom.Gedcom.Multimedia.ExternalIds:type_name -> gedcom.Gedcom.ExternalId
+	11, // 45: gedcom.Gedcom.Multimedia.Notes:type_name -> gedcom.Gedcom.NoteLink
+	12, // 46: gedcom.Gedcom.Multimedia.SourceCitations:type_name -> gedcom.Gedcom.SourceCitation
+	18, // 47: gedcom.Gedcom.Note.UserReferences:type_name -> gedcom.Gedcom.UserReference
+	19, // 48: gedcom.Gedcom.Note.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	17, // 49: gedcom.Gedcom.Note.Translations:type_name -> gedcom.Gedcom.Translation
+	16, // 50: gedcom.Gedcom.Note.ExternalIds:type_name -> gedcom.Gedcom.ExternalId
+	20, // 51: gedcom.Gedcom.Repository.Address:type_name -> gedcom.Gedcom.Address
+	18, // 52: gedcom.Gedcom.Repository.UserReferences:type_name -> gedcom.Gedcom.UserReference
+	19, // 53: gedcom.Gedcom.Repository.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	16, // 54: gedcom.Gedcom.Repository.ExternalIds:type_name -> gedcom.Gedcom.ExternalId
+	11, // 55: gedcom.Gedcom.Repository.Notes:type_name -> gedcom.Gedcom.NoteLink
+	18, // 56: gedcom.Gedcom.Source.UserReferences:type_name -> gedcom.Gedcom.UserReference
+	19, // 57: gedcom.Gedcom.Source.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	13, // 58: gedcom.Gedcom.Source.RepositoryCitations:type_name -> gedcom.Gedcom.RepositoryCitation
+	16, // 59: gedcom.Gedcom.Source.ExternalIds:type_name -> gedcom.Gedcom.ExternalId
+	11, // 60: gedcom.Gedcom.Source.Notes:type_name -> gedcom.Gedcom.NoteLink
+	10, // 61: gedcom.Gedcom.Source.MultimediaLinks:type_name -> gedcom.Gedcom.MultimediaLink
+	20, // 62: gedcom.Gedcom.Submitter.Address:type_name -> gedcom.Gedcom.Address
+	10, // 63: gedcom.Gedcom.Submitter.MultimediaLinks:type_name -> gedcom.Gedcom.MultimediaLink
+	19, // 64: gedcom.Gedcom.Submitter.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	18, // 65: gedcom.Gedcom.Submitter.UserReferences:type_name -> gedcom.Gedcom.UserReference
+	16, // 66: gedcom.Gedcom.Submitter.ExternalIds:type_name -> gedcom.Gedcom.ExternalId
+	19, // 67: gedcom.Gedcom.SubmissionType.ChangeDate:type_name -> gedcom.Gedcom.ChangeDate
+	31, // 68: gedcom.Gedcom.MultimediaLink.Files:type_name -> gedcom.Gedcom.Multimedia.File
+	32, // 69: gedcom.Gedcom.MultimediaLink.Crop:type_name -> gedcom.Gedcom.MultimediaLink.CropType
+	17, // 70: gedcom.Gedcom.NoteLink.Translations:type_name -> gedcom.Gedcom.Translation
+	11, // 71: gedcom.Gedcom.SourceCitation.Notes:type_name -> gedcom.Gedcom.NoteLink
+	11, // 72: gedcom.Gedcom.RepositoryCitation.Notes:type_name -> gedcom.Gedcom.NoteLink
+	28, // 73: gedcom.Gedcom.LdsOrdinance.StatusChangeDate:type_name -> gedcom.Gedcom.Individual.Date
+	28, // 74: gedcom.Gedcom.LdsOrdinance.Date:type_name -> gedcom.Gedcom.Individual.Date
+	12, // 75: gedcom.Gedcom.LdsOrdinance.SourceCitations:type_name -> gedcom.Gedcom.SourceCitation
+	11, // 76: gedcom.Gedcom.LdsOrdinance.Notes:type_name -> gedcom.Gedcom.NoteLink
+	12, // 77: gedcom.Gedcom.NonEvent.SourceCitations:type_name -> gedcom.Gedcom.SourceCitation
+	11, // 78: gedcom.Gedcom.NonEvent.Notes:type_name -> gedcom.Gedcom.NoteLink
+	28, // 79: gedcom.Gedcom.ChangeDate.Date:type_name -> gedcom.Gedcom.Individual.Date
+	24, // 80: gedcom.Gedcom.HeaderType.SourceSystemType.Corporation:type_name -> gedcom.Gedcom.HeaderType.SourceSystemType.CorporationType
+	25, // 81: gedcom.Gedcom.HeaderType.SourceSystemType.Data:type_name -> gedcom.Gedcom.HeaderType.SourceSystemType.DataType
+	20, // 82: gedcom.Gedcom.HeaderType.SourceSystemType.CorporationType.Address:type_name -> gedcom.Gedcom.Address
+	28, // 83: gedcom.Gedcom.HeaderType.SourceSystemType.DataType.Date:type_name -> gedcom.Gedcom.Individual.Date
+	28, // 84: gedcom.Gedcom.Individual.Event.Date:type_name -> gedcom.Gedcom.Individual.Date
+	20, // 85: gedcom.Gedcom.Individual.Event.Address:type_name -> gedcom.Gedcom.Address
+	28, // 86: gedcom.Gedcom.Individual.Event.SortDate:type_name -> gedcom.Gedcom.Individual.Date
+	12, // 87: gedcom.Gedcom.Individual.Event.SourceCitations:type_name -> gedcom.Gedcom.SourceCitation
+	11, // 88: gedcom.Gedcom.Individual.Event.Notes:type_name -> gedcom.Gedcom.NoteLink
+	10, // 89: gedcom.Gedcom.Individual.Event.MultimediaLinks:type_name -> gedcom.Gedcom.MultimediaLink
+	17, // 90: gedcom.Gedcom.Individual.Name.Translations:type_name -> gedcom.Gedcom.Translation
+	12, // 91: gedcom.Gedcom.Individual.Association.SourceCitations:type_name -> gedcom.Gedcom.SourceCitation
+	11, // 92: gedcom.Gedcom.Individual.Association.Notes:type_name -> gedcom.Gedcom.NoteLink
+	11, // 93: gedcom.Gedcom.Individual.FamilyLink.Notes:type_name -> gedcom.Gedcom.NoteLink
+	94, // [94:94] is the sub-list for method output_type
+	94, // [94:94] is the sub-list for method input_type
+	94, // [94:94] is the sub-list for extension type_name
+	94, // [94:94] is the sub-list for extension extendee
+	0,  // [0:94] is the sub-list for field type_name
 }
 
 func init() { file_gedcom_gedcom_proto_init() }
@@ -1197,7 +3919,7 @@ func file_gedcom_gedcom_proto_init() {
 			}
 		}
 		file_gedcom_gedcom_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Gedcom_HeaderType_GedcomMetaDataType); i {
+			switch v := v.(*Gedcom_SubmissionType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1209,7 +3931,7 @@ func file_gedcom_gedcom_proto_init() {
 			}
 		}
 		file_gedcom_gedcom_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Gedcom_Individual_Event); i {
+			switch v := v.(*Gedcom_MultimediaLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1221,7 +3943,7 @@ func file_gedcom_gedcom_proto_init() {
 			}
 		}
 		file_gedcom_gedcom_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Gedcom_Individual_Name); i {
+			switch v := v.(*Gedcom_NoteLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1233,7 +3955,7 @@ func file_gedcom_gedcom_proto_init() {
 			}
 		}
 		file_gedcom_gedcom_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Gedcom_Individual_Date); i {
+			switch v := v.(*Gedcom_SourceCitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1245,6 +3967,222 @@ func file_gedcom_gedcom_proto_init() {
 			}
 		}
 		file_gedcom_gedcom_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_RepositoryCitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_LdsOrdinance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_NonEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_ExternalId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_Translation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_UserReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_ChangeDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_HeaderType_GedcomMetaDataType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_HeaderType_SourceSystemType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_HeaderType_ExtensionTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_HeaderType_SourceSystemType_CorporationType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_HeaderType_SourceSystemType_DataType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_Individual_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_Individual_Name); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_Individual_Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_Individual_Association); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_Individual_FamilyLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gedcom_gedcom_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Gedcom_Multimedia_File); i {
 			case 0:
 				return &v.state
@@ -1256,6 +4194,18 @@ func file_gedcom_gedcom_proto_init() {
 				return nil
 			}
 		}
+		file_gedcom_gedcom_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gedcom_MultimediaLink_CropType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1263,7 +4213,7 @@ func file_gedcom_gedcom_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gedcom_gedcom_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
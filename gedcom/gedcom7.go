@@ -0,0 +1,440 @@
+package gedcom
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// GedcomVersion is the version of the GEDCOM standard a gedcom is serialized to
+type GedcomVersion string
+
+const (
+	// GedcomVersion551 serializes gedcoms to GEDCOM 5.5.1
+	GedcomVersion551 GedcomVersion = ""
+	// GedcomVersion7 serializes gedcoms to GEDCOM 7.0
+	GedcomVersion7 GedcomVersion = "7.0"
+)
+
+// ParseGedcomVersion parses a GEDCOM version, where an empty value or 5.5.1 serializes to GEDCOM 5.5.1
+func ParseGedcomVersion(value string) (GedcomVersion, error) {
+	switch GedcomVersion(value) {
+	case GedcomVersion551, "5.5.1":
+		return GedcomVersion551, nil
+	case GedcomVersion7, "7":
+		return GedcomVersion7, nil
+	}
+	return GedcomVersion551, fmt.Errorf("invalid GEDCOM version %s, expected one of: 5.5.1|7.0", value)
+}
+
+// isGedcom7 reports whether a header declares the gedcom as GEDCOM 7.x (2 VERS 7.0)
+func isGedcom7(header *Gedcom_HeaderType) bool {
+	return strings.HasPrefix(header.GetGedcomMetaData().GetVersionNumber(), "7.")
+}
+
+// IsGedcom7 reports whether the gedcom was read from a GEDCOM 7.x file
+func (g *ConcurrencySafeGedcom) IsGedcom7() bool {
+	return isGedcom7(g.Header)
+}
+
+// ReportInvalidUTF8 reports a line of a GEDCOM 7.x file that isn't valid UTF-8, which GEDCOM 7.0 requires.
+// The issue is reported as an error diagnostic by Validate.
+func (g *ConcurrencySafeGedcom) ReportInvalidUTF8(lineNumber int) {
+	g.lock()
+	defer g.unlock()
+	g.readDiagnostics = append(g.readDiagnostics, &Diagnostic{
+		Rule:     "encoding",
+		Severity: SeverityError,
+		Line:     lineNumber,
+		Message:  "line isn't valid UTF-8, which GEDCOM 7.0 requires",
+	})
+}
+
+// withoutVoidPointers returns the gedcom without the void pointers (@VOID@) of GEDCOM 7.0, which GEDCOM 5.5.1 doesn't have.
+// Structures left without a pointer are removed like empty pointers.
+// A gedcom holding void pointers is copied rather than changed, as it may be the gedcom being exported.
+func withoutVoidPointers(gedcom *Gedcom) *Gedcom {
+	hasVoidPointers := false
+	forEachPointerField(gedcom, func(_ string, pointer *string, _ string) {
+		hasVoidPointers = hasVoidPointers || *pointer == voidPointer
+	})
+	if !hasVoidPointers {
+		return gedcom
+	}
+	result := proto.Clone(gedcom).(*Gedcom)
+	forEachPointerField(result, func(_ string, pointer *string, _ string) {
+		if *pointer == voidPointer {
+			*pointer = ""
+		}
+	})
+	removeEmptyPointers(result)
+	return result
+}
+
+// tags of the GEDCOM 7.0 structures that are written as user-defined structures in GEDCOM 5.5.1
+var gedcom7TagsByExtensionTags = map[string]string{
+	"_UID":    "UID",
+	"_EXID":   "EXID",
+	"_NO":     "NO",
+	"_SDATE":  "SDATE",
+	"_PHRASE": "PHRASE",
+	"_CROP":   "CROP",
+	"_TRAN":   "TRAN",
+	"_SCHMA":  "SCHMA",
+}
+
+// enumerated values of the GEDCOM 7.0 role of an association (ROLE)
+var gedcom7Roles = []string{"CHIL", "CLERGY", "FATH", "FRIEND", "GODP", "HUSB", "MOTH", "MULTIPLE", "NGHBR", "OFFICIATOR", "PARENT", "SPOU", "WIFE", "WITN"}
+
+// GEDCOM 7.0 roles of relations commonly described in words by GEDCOM 5.5.1 associations (RELA)
+var gedcom7RolesByRelations = map[string]string{
+	"child":      "CHIL",
+	"clergy":     "CLERGY",
+	"father":     "FATH",
+	"friend":     "FRIEND",
+	"godfather":  "GODP",
+	"godmother":  "GODP",
+	"godparent":  "GODP",
+	"husband":    "HUSB",
+	"mother":     "MOTH",
+	"neighbor":   "NGHBR",
+	"neighbour":  "NGHBR",
+	"officiator": "OFFICIATOR",
+	"parent":     "PARENT",
+	"priest":     "CLERGY",
+	"spouse":     "SPOU",
+	"wife":       "WIFE",
+	"witness":    "WITN",
+}
+
+// enumerated values of the GEDCOM 7.0 pedigree of a child to family link (PEDI)
+var gedcom7Pedigrees = []string{"ADOPTED", "BIRTH", "FOSTER", "SEALING"}
+
+// GEDCOM 7.0 media types of the multimedia formats of GEDCOM 5.5.1 (FORM)
+var mediaTypesByFormats = map[string]string{
+	"bmp":  "image/bmp",
+	"gif":  "image/gif",
+	"jpg":  "image/jpeg",
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"tif":  "image/tiff",
+	"tiff": "image/tiff",
+	"pdf":  "application/pdf",
+	"wav":  "audio/wav",
+	"mp3":  "audio/mpeg",
+	"txt":  "text/plain",
+	"htm":  "text/html",
+	"html": "text/html",
+	"avi":  "video/x-msvideo",
+	"mpg":  "video/mpeg",
+	"mpeg": "video/mpeg",
+	"mp4":  "video/mp4",
+}
+
+// gedcomNode is a serialized line along with the lines subordinate to it
+type gedcomNode struct {
+	xRefID       string
+	tag          string
+	value        string
+	subordinates []*gedcomNode
+}
+
+// readGedcomNodes arranges serialized lines into a tree of records
+func readGedcomNodes(buf *bytes.Buffer) []*gedcomNode {
+	var records []*gedcomNode
+	var stack []*gedcomNode
+	for _, lineString := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		line := NewLine(lineString)
+		level, err := line.Level()
+		if err != nil || int(level) > len(stack) {
+			continue
+		}
+		tag, err := line.Tag()
+		if err != nil {
+			continue
+		}
+		node := &gedcomNode{
+			xRefID: line.XRefID(),
+			tag:    tag,
+			value:  line.Value(),
+		}
+		stack = append(stack[:level], node)
+		if level == 0 {
+			records = append(records, node)
+		} else {
+			parent := stack[level-1]
+			parent.subordinates = append(parent.subordinates, node)
+		}
+	}
+	return records
+}
+
+func writeGedcomNodes(nodes []*gedcomNode, level int, lineCounter *int, buf *bytes.Buffer) error {
+	for _, node := range nodes {
+		err := createAndWriteLine(level, node.xRefID, node.tag, node.value, lineCounter, buf)
+		if err != nil {
+			return err
+		}
+		err = writeGedcomNodes(node.subordinates, level+1, lineCounter, buf)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gedcom7Converter converts serialized GEDCOM 5.5.1 records to GEDCOM 7.0
+type gedcom7Converter struct {
+	usedIds  map[string]bool
+	counters map[string]int
+	// records extracted from structures GEDCOM 7.0 doesn't allow to be embedded
+	extractedRecords []*gedcomNode
+}
+
+// toGedcom7 converts a gedcom serialized as GEDCOM 5.5.1 to GEDCOM 7.0:
+//
+// * the header declares GEDCOM 7.0 and its extension tags (SCHMA), and doesn't declare a character set as UTF-8 is mandatory
+//
+// * long values aren't split over CONC lines, which GEDCOM 7.0 no longer has
+//
+// * note records become shared note records (SNOTE), and pointers to them SNOTE pointers
+//
+// * structures only GEDCOM 7.0 knows, written as user-defined structures in GEDCOM 5.5.1, get their GEDCOM 7.0 tags
+//
+// * embedded multimedia and source descriptions are extracted into records of their own
+//
+// * enumerated values, associations and aliases are written as GEDCOM 7.0 defines them
+//
+// * submission records and registered reference numbers, which GEDCOM 7.0 no longer has, are left out or become user references
+func toGedcom7(buf *bytes.Buffer) (*bytes.Buffer, error) {
+	records := readGedcomNodes(buf)
+	c := &gedcom7Converter{
+		usedIds:  map[string]bool{},
+		counters: map[string]int{},
+	}
+	for _, record := range records {
+		if record.xRefID != "" {
+			c.usedIds[record.xRefID] = true
+		}
+	}
+
+	var converted []*gedcomNode
+	var trailer *gedcomNode
+	for _, record := range records {
+		switch record.tag {
+		case "HEAD":
+			converted = append(converted, convertHeaderToGedcom7(record))
+		case "TRLR":
+			trailer = record
+		case submissionRecordTag:
+			continue
+		case noteRecordTag:
+			record.tag = "SNOTE"
+			fallthrough
+		default:
+			mergeConcLines(record)
+			record.subordinates = c.convertSubordinates(record)
+			converted = append(converted, record)
+		}
+	}
+	converted = append(converted, c.extractedRecords...)
+	if trailer != nil {
+		converted = append(converted, trailer)
+	}
+
+	result := bytes.NewBuffer([]byte{})
+	lineCounter := 0
+	err := writeGedcomNodes(converted, 0, &lineCounter, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// convertHeaderToGedcom7 declares GEDCOM 7.0 in the header, leaving out the header structures GEDCOM 7.0 no longer has
+func convertHeaderToGedcom7(header *gedcomNode) *gedcomNode {
+	subordinates := []*gedcomNode{{
+		tag:          "GEDC",
+		subordinates: []*gedcomNode{{tag: "VERS", value: string(GedcomVersion7)}},
+	}}
+	for _, subordinate := range header.subordinates {
+		switch subordinate.tag {
+		case "GEDC", "CHAR", "FILE", "SUBN":
+			continue
+		}
+		mergeConcLines(subordinate)
+		if tag, ok := gedcom7TagsByExtensionTags[subordinate.tag]; ok {
+			subordinate.tag = tag
+		}
+		subordinates = append(subordinates, subordinate)
+	}
+	header.subordinates = subordinates
+	return header
+}
+
+// mergeConcLines concatenates the values of CONC lines to the value of the line they continue, throughout a structure
+func mergeConcLines(node *gedcomNode) {
+	continued := node
+	subordinates := []*gedcomNode{}
+	for _, subordinate := range node.subordinates {
+		switch subordinate.tag {
+		case "CONC":
+			continued.value += subordinate.value
+			continue
+		case "CONT":
+			continued = subordinate
+		default:
+			mergeConcLines(subordinate)
+		}
+		subordinates = append(subordinates, subordinate)
+	}
+	node.subordinates = subordinates
+}
+
+// convertSubordinates converts the structures subordinate to a line to GEDCOM 7.0
+func (c *gedcom7Converter) convertSubordinates(node *gedcomNode) []*gedcomNode {
+	subordinates := []*gedcomNode{}
+	for _, subordinate := range node.subordinates {
+		if tag, ok := gedcom7TagsByExtensionTags[subordinate.tag]; ok {
+			subordinate.tag = tag
+		}
+		switch subordinate.tag {
+		case "NOTE":
+			if isPointer(subordinate.value) {
+				subordinate.tag = "SNOTE"
+			}
+		case "NAME":
+			if node.tag == individualRecordTag && subordinate.value == "" {
+				subordinate.value = personalNameValue(subordinate)
+			}
+		case "RESN":
+			subordinate.value = strings.ToUpper(subordinate.value)
+		case "PEDI":
+			convertEnumerationToGedcom7(subordinate, gedcom7Pedigrees, nil)
+		case "RELA":
+			subordinate.tag = "ROLE"
+			convertEnumerationToGedcom7(subordinate, gedcom7Roles, gedcom7RolesByRelations)
+		case "FORM":
+			if node.tag == "FILE" {
+				if mediaType, ok := mediaTypesByFormats[strings.ToLower(subordinate.value)]; ok {
+					subordinate.value = mediaType
+				}
+			}
+		case "ALIA":
+			if !isPointer(subordinate.value) {
+				// GEDCOM 7.0 aliases only point to individuals, other names go by a name of the type also known as
+				subordinate.tag = "NAME"
+				subordinate.subordinates = append(subordinate.subordinates, &gedcomNode{tag: "TYPE", value: "AKA"})
+			}
+		case "RFN", "RIN":
+			subordinate.subordinates = []*gedcomNode{{tag: "TYPE", value: subordinate.tag}}
+			subordinate.tag = "REFN"
+		case multimediaRecordTag:
+			if subordinate.value == "" {
+				c.extractMultimedia(subordinate)
+			}
+		case sourceRecordTag:
+			if !isPointer(subordinate.value) {
+				c.extractSource(subordinate)
+			}
+		}
+		subordinate.subordinates = c.convertSubordinates(subordinate)
+		subordinates = append(subordinates, subordinate)
+	}
+	return subordinates
+}
+
+// personalNameValue composes the value of a personal name from its given name and surname pieces, e.g. John /Smith/
+func personalNameValue(name *gedcomNode) string {
+	givenName, surname := "", ""
+	for _, subordinate := range name.subordinates {
+		switch subordinate.tag {
+		case "GIVN":
+			givenName = subordinate.value
+		case "SURN":
+			surname = subordinate.value
+		}
+	}
+	if surname == "" {
+		return givenName
+	}
+	return strings.TrimSpace(givenName + " /" + surname + "/")
+}
+
+// convertEnumerationToGedcom7 writes a value as one of the given GEDCOM 7.0 enumerated values.
+// Values that are described in words are written as the enumerated value they describe, or OTHER,
+// keeping the words as a PHRASE.
+func convertEnumerationToGedcom7(node *gedcomNode, enumeration []string, enumeratedValuesByWords map[string]string) {
+	for _, value := range enumeration {
+		if strings.EqualFold(value, node.value) {
+			node.value = value
+			return
+		}
+	}
+	phrase := node.value
+	node.value = "OTHER"
+	if value, ok := enumeratedValuesByWords[strings.ToLower(phrase)]; ok {
+		node.value = value
+	}
+	node.subordinates = append(node.subordinates, &gedcomNode{tag: "PHRASE", value: phrase})
+}
+
+// extractMultimedia moves the files of an embedded multimedia link into a multimedia record of its own,
+// turning the link into a pointer to it
+func (c *gedcom7Converter) extractMultimedia(link *gedcomNode) {
+	record := &gedcomNode{
+		xRefID: c.newXRefID(multimediaRecordTag),
+		tag:    multimediaRecordTag,
+	}
+	subordinates := []*gedcomNode{}
+	for _, subordinate := range link.subordinates {
+		if subordinate.tag == "FILE" {
+			record.subordinates = append(record.subordinates, subordinate)
+			continue
+		}
+		subordinates = append(subordinates, subordinate)
+	}
+	record.subordinates = c.convertSubordinates(record)
+	c.extractedRecords = append(c.extractedRecords, record)
+	link.value = record.xRefID
+	link.subordinates = subordinates
+}
+
+// extractSource moves the description of a source citation into a source record of its own as its title,
+// turning the citation into a pointer to it
+func (c *gedcom7Converter) extractSource(citation *gedcomNode) {
+	title := &gedcomNode{
+		tag:   "TITL",
+		value: citation.value,
+	}
+	subordinates := []*gedcomNode{}
+	for _, subordinate := range citation.subordinates {
+		if subordinate.tag == "CONT" {
+			title.subordinates = append(title.subordinates, subordinate)
+			continue
+		}
+		subordinates = append(subordinates, subordinate)
+	}
+	record := &gedcomNode{
+		xRefID:       c.newXRefID(sourceRecordTag),
+		tag:          sourceRecordTag,
+		subordinates: []*gedcomNode{title},
+	}
+	c.extractedRecords = append(c.extractedRecords, record)
+	citation.value = record.xRefID
+	citation.subordinates = subordinates
+}
+
+// newXRefID returns an xRefId for a new record that isn't used by any other record
+func (c *gedcom7Converter) newXRefID(recordTag string) string {
+	for {
+		c.counters[recordTag]++
+		id := fmt.Sprintf("@%s%d@", xRefIdPrefixes[recordTag], c.counters[recordTag])
+		if !c.usedIds[id] {
+			c.usedIds[id] = true
+			return id
+		}
+	}
+}
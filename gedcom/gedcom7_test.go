@@ -0,0 +1,232 @@
+package gedcom
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+var gedcom7Lines = []string{
+	"0 HEAD",
+	"1 GEDC",
+	"2 VERS 7.0",
+	"1 SCHMA",
+	"2 TAG _SKYPEID http://xmlns.com/foaf/0.1/skypeID",
+	"0 @I1@ INDI",
+	"1 NAME John /Smith/",
+	"2 TRAN Johann /Schmidt/",
+	"3 LANG de",
+	"1 BIRT",
+	"2 DATE 1 JAN 1900",
+	"3 PHRASE New Year's Day 1900",
+	"2 SDATE 1900",
+	"1 FAMC @F1@",
+	"2 PEDI BIRTH",
+	"1 ASSO @VOID@",
+	"2 ROLE OTHER",
+	"3 PHRASE Priest",
+	"1 NO MARR",
+	"2 DATE FROM 1920 TO 1930",
+	"2 SNOTE @N1@",
+	"1 EXID 123",
+	"2 TYPE http://example.com",
+	"0 @F1@ FAM",
+	"1 CHIL @I1@",
+	"0 @N1@ SNOTE Shared note",
+	"1 TRAN Gedeelde notitie",
+	"2 LANG nl",
+	"0 @M1@ OBJE",
+	"1 FILE photo.jpg",
+	"2 FORM image/jpeg",
+	"0 @U1@ SUBM",
+	"1 NAME Submitter",
+	"1 OBJE @M1@",
+	"2 CROP",
+	"3 TOP 10",
+	"3 HEIGHT 100",
+	"0 TRLR",
+}
+
+func TestInterpretGedcom7(t *testing.T) {
+	g := interpretGedcomLines(gedcom7Lines)
+	if !g.IsGedcom7() {
+		t.Errorf("gedcom with version 7.0 isn't detected as GEDCOM 7.0")
+	}
+	if g.Header.CharacterSet != "UTF-8" {
+		t.Errorf("expected character set UTF-8, found %s", g.Header.CharacterSet)
+	}
+	expectedExtensionTag := &Gedcom_HeaderType_ExtensionTag{Tag: "_SKYPEID", Uri: "http://xmlns.com/foaf/0.1/skypeID"}
+	if len(g.Header.ExtensionTags) != 1 || !proto.Equal(g.Header.ExtensionTags[0], expectedExtensionTag) {
+		t.Errorf("expected extension tags [%v], found %v", expectedExtensionTag, g.Header.ExtensionTags)
+	}
+
+	indi := g.Individuals[0]
+	expectedTranslation := &Gedcom_Translation{Text: "Johann /Schmidt/", Language: "de"}
+	if len(indi.Names[0].Translations) != 1 || !proto.Equal(indi.Names[0].Translations[0], expectedTranslation) {
+		t.Errorf("expected name translations [%v], found %v", expectedTranslation, indi.Names[0].Translations)
+	}
+	if indi.Names[0].GivenName != "John" || indi.Names[0].Surname != "Smith" {
+		t.Errorf("name translation overrides name: %v", indi.Names[0])
+	}
+	birth := indi.BirthEvents[0]
+	if birth.Date.Phrase != "New Year's Day 1900" || birth.Date.Year != "1900" {
+		t.Errorf("expected date 1 JAN 1900 with phrase, found %v", birth.Date)
+	}
+	if birth.SortDate.GetYear() != "1900" {
+		t.Errorf("expected sort date 1900, found %v", birth.SortDate)
+	}
+	if association := indi.Associations[0]; association.IndividualId != voidPointer || association.Relation != "Priest" {
+		t.Errorf("expected association to %s as Priest, found %v", voidPointer, association)
+	}
+	expectedNonEvent := &Gedcom_NonEvent{Event: "MARR", DatePeriod: "FROM 1920 TO 1930", Notes: []*Gedcom_NoteLink{{NoteId: "@N1@"}}}
+	if len(indi.NonEvents) != 1 || !proto.Equal(indi.NonEvents[0], expectedNonEvent) {
+		t.Errorf("expected non-events [%v], found %v", expectedNonEvent, indi.NonEvents)
+	}
+	expectedExternalId := &Gedcom_ExternalId{Id: "123", Type: "http://example.com"}
+	if len(indi.ExternalIds) != 1 || !proto.Equal(indi.ExternalIds[0], expectedExternalId) {
+		t.Errorf("expected external ids [%v], found %v", expectedExternalId, indi.ExternalIds)
+	}
+
+	if len(g.Notes) != 1 || g.Notes[0].SubmitterText != "Shared note" || len(g.Notes[0].Translations) != 1 {
+		t.Errorf("expected shared note record with a translation, found %v", g.Notes)
+	}
+	expectedCrop := &Gedcom_MultimediaLink_CropType{Top: 10, Height: 100}
+	if crop := g.Submitters[0].MultimediaLinks[0].Crop; !proto.Equal(crop, expectedCrop) {
+		t.Errorf("expected crop %v, found %v", expectedCrop, crop)
+	}
+
+	if diagnostics := g.ValidatePointerIntegrity(DanglingPointersReport); len(diagnostics) > 0 {
+		t.Errorf("expected no dangling pointers, found %v", diagnostics)
+	}
+}
+
+func TestSerializeGedcom7(t *testing.T) {
+	for _, version := range []GedcomVersion{GedcomVersion551, GedcomVersion7} {
+		g := interpretGedcomLines(gedcom7Lines)
+		buf, err := g.ToSerializedGedcom(&ExportOptions{Version: version})
+		if err != nil {
+			t.Fatalf("failed to serialize gedcom to version %q with error: %s", version, err)
+		}
+		serialized := buf.String()
+		expectedVersion := "2 VERS 5.5.1\n"
+		if version == GedcomVersion7 {
+			expectedVersion = "2 VERS 7.0\n"
+		}
+		if !strings.Contains(serialized, expectedVersion) {
+			t.Errorf("serialized gedcom doesn't declare %s", expectedVersion)
+		}
+
+		result := interpretGedcomLines(splitLines(serialized))
+		expected := proto.Clone(&g.Gedcom).(*Gedcom)
+		if version == GedcomVersion551 {
+			// GEDCOM 5.5.1 has no void pointers, so the association with @VOID@ is left out
+			expected = withoutVoidPointers(expected)
+		}
+		actual := proto.Clone(&result.Gedcom).(*Gedcom)
+		expected.Header, actual.Header = nil, nil
+		if !proto.Equal(actual, expected) {
+			t.Errorf("gedcom serialized to version %q doesn't interpret to the same gedcom:\n%s", version, serialized)
+		}
+	}
+}
+
+func TestSerializeVoidPointers(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"1 GEDC",
+		"2 VERS 7.0",
+		"0 @I1@ INDI",
+		"1 FAMS @F1@",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"1 WIFE @VOID@",
+		"1 CHIL @VOID@",
+		"2 PHRASE Stillborn son",
+		"0 TRLR",
+	})
+	cases := []struct {
+		version  GedcomVersion
+		expected int
+	}{
+		{GedcomVersion551, 0},
+		{GedcomVersion7, 2},
+	}
+	for _, c := range cases {
+		buf, err := g.ToSerializedGedcom(&ExportOptions{Version: c.version})
+		if err != nil {
+			t.Fatalf("failed to serialize gedcom to version %q with error: %s", c.version, err)
+		}
+		if count := strings.Count(buf.String(), voidPointer); count != c.expected {
+			t.Errorf("expected %d void pointers in gedcom serialized to version %q, found %d:\n%s", c.expected, c.version, count, buf.String())
+		}
+	}
+	if f := g.Families[0]; f.MotherId != voidPointer || len(f.ChildIds) != 1 {
+		t.Errorf("expected serializing not to change the gedcom, found %+v", f)
+	}
+}
+
+func TestConvertToGedcom7(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"1 GEDC",
+		"2 VERS 5.5.1",
+		"2 FORM LINEAGE-LINKED",
+		"1 CHAR UTF-8",
+		"1 SUBN @SUBN1@",
+		"0 @I1@ INDI",
+		"1 RESN privacy",
+		"1 ALIA Johnny",
+		"1 ASSO @I2@",
+		"2 RELA Godfather",
+		"2 SOUR Parish register",
+		"1 FAMC @F1@",
+		"2 PEDI step",
+		"1 RIN 42",
+		"0 @I2@ INDI",
+		"1 NAME",
+		"2 GIVN Jane",
+		"2 SURN Doe",
+		"0 @F1@ FAM",
+		"0 @N1@ NOTE " + strings.Repeat("long ", 30),
+		"1 CONC " + strings.TrimSpace(strings.Repeat("note ", 30)),
+		"0 @U1@ SUBM",
+		"1 NAME Submitter",
+		"1 OBJE",
+		"2 FILE photo.jpg",
+		"3 FORM jpg",
+		"1 RFN 7",
+		"0 @SUBN1@ SUBN",
+		"0 TRLR",
+	})
+	buf, err := g.ToSerializedGedcom(&ExportOptions{Version: GedcomVersion7})
+	if err != nil {
+		t.Fatalf("failed to serialize gedcom to GEDCOM 7.0 with error: %s", err)
+	}
+	serialized := buf.String()
+	for _, expected := range []string{
+		"0 HEAD\n1 GEDC\n2 VERS 7.0\n",
+		"1 RESN PRIVACY\n",
+		"1 NAME Johnny\n2 TYPE AKA\n",
+		"1 NAME Jane /Doe/\n2 GIVN Jane\n2 SURN Doe\n",
+		"2 ROLE GODP\n3 PHRASE Godfather\n",
+		"2 SOUR @S1@\n",
+		"0 @S1@ SOUR\n1 TITL Parish register\n",
+		"2 PEDI OTHER\n3 PHRASE step\n",
+		"1 REFN 42\n2 TYPE RIN\n",
+		"1 REFN 7\n2 TYPE RFN\n",
+		"0 @N1@ SNOTE " + strings.Repeat("long ", 30) + strings.TrimSpace(strings.Repeat("note ", 30)) + "\n",
+		"1 OBJE @M1@\n",
+		"0 @M1@ OBJE\n1 FILE photo.jpg\n2 FORM image/jpeg\n",
+		"0 TRLR\n",
+	} {
+		if !strings.Contains(serialized, expected) {
+			t.Errorf("GEDCOM 7.0 doesn't contain %q:\n%s", expected, serialized)
+		}
+	}
+	for _, unexpected := range []string{"CONC", "CHAR", "SUBN", "RELA", "FORM LINEAGE-LINKED"} {
+		if strings.Contains(serialized, unexpected) {
+			t.Errorf("GEDCOM 7.0 contains %s:\n%s", unexpected, serialized)
+		}
+	}
+}
@@ -0,0 +1,663 @@
+package gedcom
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jochenboesmans/gedcom-parser/util"
+	"regexp"
+	"strings"
+)
+
+// JSONFormat is the format of JSON files representing a gedcom
+type JSONFormat string
+
+const (
+	// JSONFormatGedcom is the JSON representation of the gedcom structure itself
+	JSONFormatGedcom JSONFormat = ""
+	// JSONFormatGedcomX is GEDCOM X JSON, the data model of FamilySearch
+	JSONFormatGedcomX JSONFormat = "gedcomx"
+)
+
+// ParseJSONFormat parses a JSON format, where an empty value or gedcom is the JSON representation of the gedcom structure
+func ParseJSONFormat(value string) (JSONFormat, error) {
+	switch JSONFormat(value) {
+	case JSONFormatGedcom, "gedcom":
+		return JSONFormatGedcom, nil
+	case JSONFormatGedcomX:
+		return JSONFormatGedcomX, nil
+	}
+	return JSONFormatGedcom, fmt.Errorf("invalid JSON format %s, expected one of: gedcom|gedcomx", value)
+}
+
+const gedcomXTypePrefix = "http://gedcomx.org/"
+
+const (
+	gedcomXCouple      = gedcomXTypePrefix + "Couple"
+	gedcomXParentChild = gedcomXTypePrefix + "ParentChild"
+	gedcomXGiven       = gedcomXTypePrefix + "Given"
+	gedcomXSurname     = gedcomXTypePrefix + "Surname"
+	gedcomXMarriage    = gedcomXTypePrefix + "Marriage"
+)
+
+type gedcomX struct {
+	Persons            []*gedcomXPerson            `json:"persons,omitempty"`
+	Relationships      []*gedcomXRelationship      `json:"relationships,omitempty"`
+	SourceDescriptions []*gedcomXSourceDescription `json:"sourceDescriptions,omitempty"`
+	Places             []*gedcomXPlaceDescription  `json:"places,omitempty"`
+}
+
+type gedcomXPerson struct {
+	Id      string                    `json:"id"`
+	Gender  *gedcomXGender            `json:"gender,omitempty"`
+	Names   []*gedcomXName            `json:"names,omitempty"`
+	Facts   []*gedcomXFact            `json:"facts,omitempty"`
+	Sources []*gedcomXSourceReference `json:"sources,omitempty"`
+}
+
+type gedcomXGender struct {
+	Type string `json:"type"`
+}
+
+type gedcomXName struct {
+	Preferred bool               `json:"preferred,omitempty"`
+	NameForms []*gedcomXNameForm `json:"nameForms"`
+}
+
+type gedcomXNameForm struct {
+	FullText string             `json:"fullText,omitempty"`
+	Parts    []*gedcomXNamePart `json:"parts,omitempty"`
+}
+
+type gedcomXNamePart struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type gedcomXFact struct {
+	Type    string                 `json:"type"`
+	Primary bool                   `json:"primary,omitempty"`
+	Date    *gedcomXDate           `json:"date,omitempty"`
+	Place   *gedcomXPlaceReference `json:"place,omitempty"`
+}
+
+type gedcomXDate struct {
+	Original string `json:"original,omitempty"`
+	Formal   string `json:"formal,omitempty"`
+}
+
+type gedcomXPlaceReference struct {
+	Original    string `json:"original,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type gedcomXRelationship struct {
+	Id      string                    `json:"id"`
+	Type    string                    `json:"type"`
+	Person1 *gedcomXResourceReference `json:"person1"`
+	Person2 *gedcomXResourceReference `json:"person2"`
+	Facts   []*gedcomXFact            `json:"facts,omitempty"`
+	Sources []*gedcomXSourceReference `json:"sources,omitempty"`
+}
+
+type gedcomXResourceReference struct {
+	Resource string `json:"resource"`
+}
+
+type gedcomXSourceDescription struct {
+	Id        string              `json:"id"`
+	Titles    []*gedcomXTextValue `json:"titles,omitempty"`
+	Citations []*gedcomXTextValue `json:"citations,omitempty"`
+}
+
+type gedcomXSourceReference struct {
+	Description string `json:"description"`
+}
+
+type gedcomXPlaceDescription struct {
+	Id    string              `json:"id"`
+	Names []*gedcomXTextValue `json:"names"`
+}
+
+type gedcomXTextValue struct {
+	Value string `json:"value"`
+}
+
+var gedcomXGendersByGenders = map[string]string{
+	"MALE":   gedcomXTypePrefix + "Male",
+	"FEMALE": gedcomXTypePrefix + "Female",
+}
+
+var gendersByGedcomXGenders = util.InvertStringStringMap(gedcomXGendersByGenders)
+
+// GEDCOM X parent-child relationship fact types of pedigrees (PEDI)
+var gedcomXParentFactsByPedigrees = map[string]string{
+	"birth":   gedcomXTypePrefix + "BiologicalParent",
+	"adopted": gedcomXTypePrefix + "AdoptiveParent",
+	"foster":  gedcomXTypePrefix + "FosterParent",
+}
+
+var pedigreesByGedcomXParentFacts = util.InvertStringStringMap(gedcomXParentFactsByPedigrees)
+
+// ToGedcomX writes a gedcom as GEDCOM X JSON: individuals become persons with names and facts,
+// families become couple and parent-child relationships, sources become source descriptions with their title
+// and a citation made of their author, title and publication facts, and the places of events become place descriptions
+func (g *ConcurrencySafeGedcom) ToGedcomX(options *ExportOptions) (*[]byte, error) {
+	gedcomXJson, err := json.Marshal(toGedcomX(g.exportedGedcom(options)))
+	if err != nil {
+		return nil, err
+	}
+	return &gedcomXJson, nil
+}
+
+// gedcomXPlaces assigns ids to the distinct places of events
+type gedcomXPlaces struct {
+	idsByNames   map[string]string
+	descriptions []*gedcomXPlaceDescription
+}
+
+func (p *gedcomXPlaces) reference(place string) *gedcomXPlaceReference {
+	if place == "" {
+		return nil
+	}
+	id, ok := p.idsByNames[place]
+	if !ok {
+		id = fmt.Sprintf("P%d", len(p.descriptions)+1)
+		p.idsByNames[place] = id
+		p.descriptions = append(p.descriptions, &gedcomXPlaceDescription{
+			Id:    id,
+			Names: []*gedcomXTextValue{{Value: place}},
+		})
+	}
+	return &gedcomXPlaceReference{
+		Original:    place,
+		Description: "#" + id,
+	}
+}
+
+func toGedcomX(gedcom *Gedcom) *gedcomX {
+	x := &gedcomX{}
+	places := &gedcomXPlaces{idsByNames: map[string]string{}}
+	individualsByIds := map[string]*Gedcom_Individual{}
+
+	for _, i := range gedcom.Individuals {
+		individualsByIds[i.Id] = i
+		person := &gedcomXPerson{
+			Id:      gedcomXId(i.Id),
+			Sources: toGedcomXSourceReferences(SourceCitations(i)),
+		}
+		if gender, ok := gedcomXGendersByGenders[i.Gender]; ok {
+			person.Gender = &gedcomXGender{Type: gender}
+		} else {
+			person.Gender = &gedcomXGender{Type: gedcomXTypePrefix + "Unknown"}
+		}
+		for _, n := range i.Names {
+			person.Names = append(person.Names, toGedcomXName(n))
+		}
+		for _, eventType := range IndividualEventTypes {
+			for _, event := range *eventType.Events(i) {
+				person.Facts = append(person.Facts, toGedcomXFact(gedcomXTypePrefix+eventType.Name, event, places))
+			}
+		}
+		x.Persons = append(x.Persons, person)
+	}
+
+	for _, f := range gedcom.Families {
+		if f.FatherId != "" && f.MotherId != "" {
+			couple := &gedcomXRelationship{
+				Id:      gedcomXId(f.Id),
+				Type:    gedcomXCouple,
+				Person1: gedcomXPersonReference(f.FatherId),
+				Person2: gedcomXPersonReference(f.MotherId),
+				Sources: toGedcomXSourceReferences(SourceCitations(f)),
+			}
+			for _, event := range f.MarriageEvents {
+				couple.Facts = append(couple.Facts, toGedcomXFact(gedcomXMarriage, event, places))
+			}
+			x.Relationships = append(x.Relationships, couple)
+		}
+		for _, childId := range f.ChildIds {
+			var facts []*gedcomXFact
+			for _, link := range individualsByIds[childId].GetChildToFamilyLinks() {
+				if factType, ok := gedcomXParentFactsByPedigrees[strings.ToLower(link.Pedigree)]; ok && link.FamilyId == f.Id {
+					facts = append(facts, &gedcomXFact{Type: factType})
+				}
+			}
+			for _, parentId := range []string{f.FatherId, f.MotherId} {
+				if parentId == "" {
+					continue
+				}
+				x.Relationships = append(x.Relationships, &gedcomXRelationship{
+					Id:      fmt.Sprintf("%s-%s-%s", gedcomXId(f.Id), gedcomXId(parentId), gedcomXId(childId)),
+					Type:    gedcomXParentChild,
+					Person1: gedcomXPersonReference(parentId),
+					Person2: gedcomXPersonReference(childId),
+					Facts:   facts,
+				})
+			}
+		}
+	}
+
+	for _, s := range gedcom.Sources {
+		x.SourceDescriptions = append(x.SourceDescriptions, toGedcomXSourceDescription(s))
+	}
+	x.Places = places.descriptions
+	return x
+}
+
+func toGedcomXName(name *Gedcom_Individual_Name) *gedcomXName {
+	nameForm := &gedcomXNameForm{
+		FullText: strings.TrimSpace(name.GivenName + " " + name.Surname),
+	}
+	if name.GivenName != "" {
+		nameForm.Parts = append(nameForm.Parts, &gedcomXNamePart{Type: gedcomXGiven, Value: name.GivenName})
+	}
+	if name.Surname != "" {
+		nameForm.Parts = append(nameForm.Parts, &gedcomXNamePart{Type: gedcomXSurname, Value: name.Surname})
+	}
+	return &gedcomXName{
+		Preferred: name.Primary,
+		NameForms: []*gedcomXNameForm{nameForm},
+	}
+}
+
+func toGedcomXFact(factType string, event *Gedcom_Individual_Event, places *gedcomXPlaces) *gedcomXFact {
+	fact := &gedcomXFact{
+		Type:    factType,
+		Primary: event.Primary,
+		Place:   places.reference(event.Place),
+	}
+	original := toDateValue(event.Date)
+	if original == "" {
+		original = event.Date.GetPhrase()
+	}
+	if original != "" {
+		fact.Date = &gedcomXDate{
+			Original: original,
+			Formal:   toGedcomXFormalDate(event.Date),
+		}
+	}
+	return fact
+}
+
+var formalYearPattern = regexp.MustCompile(`^\d{4}$`)
+
+// toGedcomXFormalDate formats a date as a GEDCOM X formal date (e.g. +1900-01-31),
+// returning an empty string for dates without a plain year
+func toGedcomXFormalDate(date *Gedcom_Individual_Date) string {
+	if !formalYearPattern.MatchString(date.GetYear()) {
+		return ""
+	}
+	formal := "+" + date.Year
+	if _, ok := util.MonthAbbrByInt[date.Month]; ok {
+		formal += "-" + date.Month
+		switch len(date.Day) {
+		case 1:
+			formal += "-0" + date.Day
+		case 2:
+			formal += "-" + date.Day
+		}
+	}
+	return formal
+}
+
+func toGedcomXSourceDescription(source *Gedcom_Source) *gedcomXSourceDescription {
+	description := &gedcomXSourceDescription{Id: gedcomXId(source.Id)}
+	if source.Title != "" {
+		description.Titles = []*gedcomXTextValue{{Value: source.Title}}
+	}
+	var citation []string
+	for _, value := range []string{source.Author, source.Title, source.Publication} {
+		if value != "" {
+			citation = append(citation, value)
+		}
+	}
+	if len(citation) > 0 {
+		description.Citations = []*gedcomXTextValue{{Value: strings.Join(citation, ", ")}}
+	}
+	return description
+}
+
+func toGedcomXSourceReferences(citations []*Gedcom_SourceCitation) []*gedcomXSourceReference {
+	var references []*gedcomXSourceReference
+	referenced := map[string]bool{}
+	for _, citation := range citations {
+		if citation.SourceId == "" || referenced[citation.SourceId] {
+			continue
+		}
+		referenced[citation.SourceId] = true
+		references = append(references, &gedcomXSourceReference{Description: "#" + gedcomXId(citation.SourceId)})
+	}
+	return references
+}
+
+// gedcomXId returns the GEDCOM X id of a record, which is its xRefId without the surrounding @s
+func gedcomXId(xRefID string) string {
+	return strings.Trim(xRefID, "@")
+}
+
+func gedcomXPersonReference(xRefID string) *gedcomXResourceReference {
+	return &gedcomXResourceReference{Resource: "#" + gedcomXId(xRefID)}
+}
+
+// xRefIdFromGedcomX returns the xRefId of a record from a GEDCOM X id or a local reference to it (#id)
+func xRefIdFromGedcomX(reference string) string {
+	id := strings.TrimPrefix(reference, "#")
+	if id == "" || isXRefID(id) {
+		return id
+	}
+	return "@" + id + "@"
+}
+
+// InterpretGedcomX interprets GEDCOM X JSON into a gedcom structure, without validating it.
+// Persons become individuals, couple relationships become families and children are added to the families
+// of the couples their parent-child relationships refer to, creating families for parents who aren't a couple.
+// The sources persons and couples refer to are cited by their individuals and families.
+func InterpretGedcomX(gedcomXJson []byte) (*ConcurrencySafeGedcom, error) {
+	x := &gedcomX{}
+	err := json.Unmarshal(gedcomXJson, x)
+	if err != nil {
+		return nil, err
+	}
+
+	g := NewConcurrencySafeGedcom()
+	g.Header = &Gedcom_HeaderType{}
+
+	// null elements of the arrays of GEDCOM X JSON are skipped
+	placeNamesByIds := map[string]string{}
+	for _, p := range x.Places {
+		if p != nil && len(p.Names) > 0 && p.Names[0] != nil {
+			placeNamesByIds[p.Id] = p.Names[0].Value
+		}
+	}
+
+	individualsByIds := map[string]*Gedcom_Individual{}
+	for _, p := range x.Persons {
+		if p == nil {
+			continue
+		}
+		i := &Gedcom_Individual{
+			Id:              xRefIdFromGedcomX(p.Id),
+			Gender:          gendersByGedcomXGenders[p.Gender.getType()],
+			SourceCitations: fromGedcomXSourceReferences(p.Sources),
+		}
+		for _, n := range p.Names {
+			if n == nil {
+				continue
+			}
+			i.Names = append(i.Names, fromGedcomXName(n))
+		}
+		for _, fact := range p.Facts {
+			if fact == nil {
+				continue
+			}
+			for _, eventType := range IndividualEventTypes {
+				if fact.Type == gedcomXTypePrefix+eventType.Name {
+					events := eventType.Events(i)
+					*events = append(*events, fromGedcomXFact(fact, placeNamesByIds))
+				}
+			}
+		}
+		individualsByIds[i.Id] = i
+		g.Individuals = append(g.Individuals, i)
+	}
+
+	families := &gedcomXFamilies{
+		gedcom:           g,
+		individualsByIds: individualsByIds,
+		familiesByCouple: map[[2]string]*Gedcom_Family{},
+		usedIds:          map[string]bool{},
+	}
+	for _, r := range x.Relationships {
+		if r != nil && r.Type == gedcomXCouple {
+			families.usedIds[xRefIdFromGedcomX(r.Id)] = true
+		}
+	}
+	var childIds []string
+	parentLinksByChildIds := map[string][]*gedcomXParentLink{}
+	for _, r := range x.Relationships {
+		if r == nil {
+			continue
+		}
+		switch r.Type {
+		case gedcomXCouple:
+			f := families.add(xRefIdFromGedcomX(r.Id), xRefIdFromGedcomX(r.Person1.getResource()), xRefIdFromGedcomX(r.Person2.getResource()))
+			f.SourceCitations = fromGedcomXSourceReferences(r.Sources)
+			for _, fact := range r.Facts {
+				if fact != nil && fact.Type == gedcomXMarriage {
+					f.MarriageEvents = append(f.MarriageEvents, fromGedcomXFact(fact, placeNamesByIds))
+				}
+			}
+		case gedcomXParentChild:
+			childId := xRefIdFromGedcomX(r.Person2.getResource())
+			if _, ok := parentLinksByChildIds[childId]; !ok {
+				childIds = append(childIds, childId)
+			}
+			link := &gedcomXParentLink{parentId: xRefIdFromGedcomX(r.Person1.getResource())}
+			for _, fact := range r.Facts {
+				if fact == nil {
+					continue
+				}
+				if pedigree, ok := pedigreesByGedcomXParentFacts[fact.Type]; ok {
+					link.pedigree = pedigree
+				}
+			}
+			parentLinksByChildIds[childId] = append(parentLinksByChildIds[childId], link)
+		}
+	}
+	for _, childId := range childIds {
+		families.addChild(childId, parentLinksByChildIds[childId])
+	}
+
+	for _, s := range x.SourceDescriptions {
+		if s == nil {
+			continue
+		}
+		source := &Gedcom_Source{Id: xRefIdFromGedcomX(s.Id)}
+		if len(s.Titles) > 0 && s.Titles[0] != nil {
+			source.Title = s.Titles[0].Value
+		}
+		g.Sources = append(g.Sources, source)
+	}
+	return g, nil
+}
+
+func fromGedcomXSourceReferences(references []*gedcomXSourceReference) []*Gedcom_SourceCitation {
+	var citations []*Gedcom_SourceCitation
+	for _, reference := range references {
+		if reference != nil && reference.Description != "" {
+			citations = append(citations, &Gedcom_SourceCitation{SourceId: xRefIdFromGedcomX(reference.Description)})
+		}
+	}
+	return citations
+}
+
+func (g *gedcomXGender) getType() string {
+	if g == nil {
+		return ""
+	}
+	return g.Type
+}
+
+func (r *gedcomXResourceReference) getResource() string {
+	if r == nil {
+		return ""
+	}
+	return r.Resource
+}
+
+func fromGedcomXName(name *gedcomXName) *Gedcom_Individual_Name {
+	n := &Gedcom_Individual_Name{
+		Primary: name.Preferred,
+	}
+	if len(name.NameForms) == 0 || name.NameForms[0] == nil {
+		return n
+	}
+	nameForm := name.NameForms[0]
+	for _, part := range nameForm.Parts {
+		if part == nil {
+			continue
+		}
+		switch part.Type {
+		case gedcomXGiven:
+			n.GivenName = part.Value
+		case gedcomXSurname:
+			n.Surname = part.Value
+		}
+	}
+	if len(nameForm.Parts) == 0 {
+		n.GivenName = nameForm.FullText
+	}
+	return n
+}
+
+func fromGedcomXFact(fact *gedcomXFact, placeNamesByIds map[string]string) *Gedcom_Individual_Event {
+	event := &Gedcom_Individual_Event{
+		Primary: fact.Primary,
+	}
+	if fact.Date != nil {
+		original := fact.Date.Original
+		if original == "" {
+			original = fromGedcomXFormalDate(fact.Date.Formal)
+		}
+		date := interpretDateStructure(NewLine("2 DATE " + original))
+		event.Date = &Gedcom_Individual_Date{
+			Year:  date.Year,
+			Month: date.Month,
+			Day:   date.Day,
+		}
+	}
+	if fact.Place != nil {
+		event.Place = fact.Place.Original
+		if event.Place == "" {
+			event.Place = placeNamesByIds[strings.TrimPrefix(fact.Place.Description, "#")]
+		}
+	}
+	return event
+}
+
+var formalDatePattern = regexp.MustCompile(`^[+-]?(\d{4})(?:-(\d{2}))?(?:-(\d{2}))?`)
+
+// fromGedcomXFormalDate formats a simple GEDCOM X formal date as the value of a DATE line
+func fromGedcomXFormalDate(formal string) string {
+	match := formalDatePattern.FindStringSubmatch(formal)
+	if match == nil {
+		return ""
+	}
+	return toDateValue(&Gedcom_Individual_Date{
+		Year:  match[1],
+		Month: match[2],
+		Day:   strings.TrimPrefix(match[3], "0"),
+	})
+}
+
+// gedcomXParentLink is a parent of a child in a GEDCOM X parent-child relationship
+type gedcomXParentLink struct {
+	parentId string
+	pedigree string
+}
+
+// gedcomXFamilies builds the families of a gedcom from GEDCOM X relationships
+type gedcomXFamilies struct {
+	gedcom           *ConcurrencySafeGedcom
+	individualsByIds map[string]*Gedcom_Individual
+	familiesByCouple map[[2]string]*Gedcom_Family
+	usedIds          map[string]bool
+}
+
+// add adds a family of a couple, in which men are fathers and women are mothers
+func (f *gedcomXFamilies) add(id string, person1Id string, person2Id string) *Gedcom_Family {
+	fatherId, motherId := person1Id, person2Id
+	if f.individualsByIds[person1Id].GetGender() == "FEMALE" || f.individualsByIds[person2Id].GetGender() == "MALE" {
+		fatherId, motherId = motherId, fatherId
+	}
+	family := &Gedcom_Family{
+		Id:       id,
+		FatherId: fatherId,
+		MotherId: motherId,
+	}
+	f.usedIds[id] = true
+	f.familiesByCouple[coupleKey(person1Id, person2Id)] = family
+	for _, spouseId := range []string{fatherId, motherId} {
+		if spouse, ok := f.individualsByIds[spouseId]; ok {
+			spouse.SpouseToFamilyLinks = append(spouse.SpouseToFamilyLinks, &Gedcom_Individual_FamilyLink{FamilyId: id})
+		}
+	}
+	f.gedcom.Families = append(f.gedcom.Families, family)
+	return family
+}
+
+// addChild adds a child to the families of its parents.
+// Parents who are a couple are grouped into the family of that couple, e.g. birth parents and adoptive parents
+// into two families. The other parents are grouped two by two by their pedigree into families of their own.
+func (f *gedcomXFamilies) addChild(childId string, parentLinks []*gedcomXParentLink) {
+	grouped := make([]bool, len(parentLinks))
+	for i := range parentLinks {
+		for j := i + 1; j < len(parentLinks) && !grouped[i]; j++ {
+			if grouped[j] {
+				continue
+			}
+			if _, ok := f.familiesByCouple[coupleKey(parentLinks[i].parentId, parentLinks[j].parentId)]; ok {
+				grouped[i], grouped[j] = true, true
+				f.addChildToFamily(childId, parentLinks[i], parentLinks[j])
+			}
+		}
+	}
+
+	var pedigrees []string
+	ungroupedByPedigrees := map[string][]*gedcomXParentLink{}
+	for i, link := range parentLinks {
+		if grouped[i] {
+			continue
+		}
+		if _, ok := ungroupedByPedigrees[link.pedigree]; !ok {
+			pedigrees = append(pedigrees, link.pedigree)
+		}
+		ungroupedByPedigrees[link.pedigree] = append(ungroupedByPedigrees[link.pedigree], link)
+	}
+	for _, pedigree := range pedigrees {
+		links := ungroupedByPedigrees[pedigree]
+		for i := 0; i < len(links); i += 2 {
+			parent2Link := &gedcomXParentLink{}
+			if i+1 < len(links) {
+				parent2Link = links[i+1]
+			}
+			f.addChildToFamily(childId, links[i], parent2Link)
+		}
+	}
+}
+
+// addChildToFamily adds a child to the family of two of its parents, creating it if they aren't a couple yet
+func (f *gedcomXFamilies) addChildToFamily(childId string, parent1Link *gedcomXParentLink, parent2Link *gedcomXParentLink) {
+	family, ok := f.familiesByCouple[coupleKey(parent1Link.parentId, parent2Link.parentId)]
+	if !ok {
+		family = f.add(f.newId(), parent1Link.parentId, parent2Link.parentId)
+	}
+	family.ChildIds = append(family.ChildIds, childId)
+
+	pedigree := parent1Link.pedigree
+	if parent2Link.pedigree != "" {
+		pedigree = parent2Link.pedigree
+	}
+	if child, ok := f.individualsByIds[childId]; ok {
+		child.ChildToFamilyLinks = append(child.ChildToFamilyLinks, &Gedcom_Individual_FamilyLink{
+			FamilyId: family.Id,
+			Pedigree: pedigree,
+		})
+	}
+}
+
+func (f *gedcomXFamilies) newId() string {
+	for n := len(f.gedcom.Families) + 1; ; n++ {
+		id := fmt.Sprintf("@F%d@", n)
+		if !f.usedIds[id] {
+			return id
+		}
+	}
+}
+
+func coupleKey(person1Id string, person2Id string) [2]string {
+	if person1Id > person2Id {
+		return [2]string{person2Id, person1Id}
+	}
+	return [2]string{person1Id, person2Id}
+}
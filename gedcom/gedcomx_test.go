@@ -0,0 +1,247 @@
+package gedcom
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func gedcomXTestGedcom() *ConcurrencySafeGedcom {
+	return interpretGedcomLines([]string{
+		"0 HEAD",
+		"0 @I1@ INDI",
+		"1 NAME John /Smith/",
+		"1 SEX M",
+		"1 BIRT",
+		"2 DATE 1 JAN 1900",
+		"2 PLAC London",
+		"1 FAMS @F1@",
+		"0 @I2@ INDI",
+		"1 NAME Jane /Doe/",
+		"1 SEX F",
+		"1 DEAT",
+		"2 DATE 1980",
+		"2 PLAC London",
+		"1 FAMS @F1@",
+		"0 @I3@ INDI",
+		"1 NAME Jim /Smith/",
+		"1 FAMC @F1@",
+		"2 PEDI adopted",
+		"1 ASSO @I1@",
+		"2 RELA Godfather",
+		"2 SOUR @S1@",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"1 WIFE @I2@",
+		"1 CHIL @I3@",
+		"1 MARR",
+		"2 DATE MAR 1925",
+		"0 @S1@ SOUR",
+		"1 AUTH Parish of London",
+		"1 TITL Baptisms 1900-1910",
+		"0 TRLR",
+	})
+}
+
+func TestToGedcomX(t *testing.T) {
+	g := gedcomXTestGedcom()
+	output, err := g.ToGedcomX(nil)
+	if err != nil {
+		t.Fatalf("failed to write GEDCOM X with error: %s", err)
+	}
+	x := &gedcomX{}
+	if err := json.Unmarshal(*output, x); err != nil {
+		t.Fatalf("failed to unmarshal GEDCOM X with error: %s", err)
+	}
+
+	if len(x.Persons) != 3 || len(x.Relationships) != 3 || len(x.SourceDescriptions) != 1 || len(x.Places) != 1 {
+		t.Fatalf("expected 3 persons, 3 relationships, 1 source description and 1 place, found %s", *output)
+	}
+	var john *gedcomXPerson
+	for _, p := range x.Persons {
+		if p.Id == "I1" {
+			john = p
+		}
+	}
+	if john == nil || john.Gender.Type != "http://gedcomx.org/Male" || john.Names[0].NameForms[0].FullText != "John Smith" {
+		t.Fatalf("expected person I1 named John Smith, found %s", *output)
+	}
+	birth := john.Facts[0]
+	if birth.Type != "http://gedcomx.org/Birth" || birth.Date.Original != "1 JAN 1900" || birth.Date.Formal != "+1900-01-01" {
+		t.Errorf("expected birth on 1 JAN 1900, found %v", birth.Date)
+	}
+	if birth.Place.Original != "London" || birth.Place.Description != "#P1" {
+		t.Errorf("expected birth in London (#P1), found %v", birth.Place)
+	}
+
+	source := x.SourceDescriptions[0]
+	if len(source.Titles) != 1 || source.Titles[0].Value != "Baptisms 1900-1910" ||
+		len(source.Citations) != 1 || source.Citations[0].Value != "Parish of London, Baptisms 1900-1910" {
+		t.Errorf("expected source description with title and citation, found %s", *output)
+	}
+
+	relationshipTypes := map[string]int{}
+	for _, r := range x.Relationships {
+		relationshipTypes[r.Type]++
+		if r.Type == gedcomXParentChild && (len(r.Facts) != 1 || r.Facts[0].Type != "http://gedcomx.org/AdoptiveParent") {
+			t.Errorf("expected adoptive parent-child relationship, found %v", r.Facts)
+		}
+	}
+	if relationshipTypes[gedcomXCouple] != 1 || relationshipTypes[gedcomXParentChild] != 2 {
+		t.Errorf("expected 1 couple and 2 parent-child relationships, found %v", relationshipTypes)
+	}
+}
+
+func TestInterpretGedcomX(t *testing.T) {
+	g := gedcomXTestGedcom()
+	output, err := g.ToGedcomX(nil)
+	if err != nil {
+		t.Fatalf("failed to write GEDCOM X with error: %s", err)
+	}
+	result, err := InterpretGedcomX(*output)
+	if err != nil {
+		t.Fatalf("failed to interpret GEDCOM X with error: %s", err)
+	}
+
+	if len(result.Individuals) != 3 || len(result.Families) != 1 || len(result.Sources) != 1 {
+		t.Fatalf("expected 3 individuals, 1 family and 1 source, found %v", &result.Gedcom)
+	}
+	expectedFamily := &Gedcom_Family{
+		Id:             "@F1@",
+		FatherId:       "@I1@",
+		MotherId:       "@I2@",
+		ChildIds:       []string{"@I3@"},
+		MarriageEvents: []*Gedcom_Individual_Event{{Date: &Gedcom_Individual_Date{Year: "1925", Month: "03"}}},
+	}
+	if !proto.Equal(result.Families[0], expectedFamily) {
+		t.Errorf("expected family %v, found %v", expectedFamily, result.Families[0])
+	}
+	individuals := result.IndividualsByIds()
+	for id, expected := range map[string]*Gedcom_Individual{
+		"@I1@": {
+			Id:                  "@I1@",
+			Names:               []*Gedcom_Individual_Name{{GivenName: "John", Surname: "Smith"}},
+			Gender:              "MALE",
+			BirthEvents:         []*Gedcom_Individual_Event{{Date: &Gedcom_Individual_Date{Year: "1900", Month: "01", Day: "1"}, Place: "London"}},
+			SpouseToFamilyLinks: []*Gedcom_Individual_FamilyLink{{FamilyId: "@F1@"}},
+		},
+		"@I3@": {
+			Id:                 "@I3@",
+			Names:              []*Gedcom_Individual_Name{{GivenName: "Jim", Surname: "Smith"}},
+			ChildToFamilyLinks: []*Gedcom_Individual_FamilyLink{{FamilyId: "@F1@", Pedigree: "adopted"}},
+			SourceCitations:    []*Gedcom_SourceCitation{{SourceId: "@S1@"}},
+		},
+	} {
+		if !proto.Equal(individuals[id], expected) {
+			t.Errorf("expected individual %v, found %v", expected, individuals[id])
+		}
+	}
+}
+
+func TestInterpretGedcomXParentsWithoutCouple(t *testing.T) {
+	result, err := InterpretGedcomX([]byte(`{
+		"persons": [{"id": "P1"}, {"id": "P2"}, {"id": "P3"}],
+		"relationships": [
+			{"id": "R1", "type": "http://gedcomx.org/ParentChild", "person1": {"resource": "#P1"}, "person2": {"resource": "#P2"}},
+			{"id": "R2", "type": "http://gedcomx.org/ParentChild", "person1": {"resource": "#P1"}, "person2": {"resource": "#P3"}}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("failed to interpret GEDCOM X with error: %s", err)
+	}
+	if len(result.Families) != 1 {
+		t.Fatalf("expected 1 family for the single parent, found %v", result.Families)
+	}
+	family := result.Families[0]
+	if family.Id != "@F1@" || family.FatherId != "@P1@" || len(family.ChildIds) != 2 {
+		t.Errorf("expected family @F1@ of @P1@ with 2 children, found %v", family)
+	}
+}
+
+func TestInterpretGedcomXWithNulls(t *testing.T) {
+	for _, c := range []struct {
+		gedcomXJson         string
+		expectedIndividuals int
+	}{
+		{`{"persons":[null]}`, 0},
+		{`{"relationships":[null]}`, 0},
+		{`{"sourceDescriptions":[null, {"id": "S1", "titles": [null]}]}`, 0},
+		{`{"places":[null, {"id": "L1", "names": [null]}]}`, 0},
+		{`{"persons":[{"id": "P1", "names":[null], "facts":[null], "sources":[null]}]}`, 1},
+		{`{"persons":[{"id": "P1", "names":[{"nameForms":[null]}, {"nameForms":[{"parts":[null]}]}]}]}`, 1},
+		{`{"persons":[{"id": "P1"}, {"id": "P2"}], "relationships":[
+			{"id": "R1", "type": "http://gedcomx.org/Couple", "person1": {"resource": "#P1"}, "person2": {"resource": "#P2"}, "facts":[null]},
+			{"id": "R2", "type": "http://gedcomx.org/ParentChild", "person1": {"resource": "#P1"}, "person2": {"resource": "#P2"}, "facts":[null]}
+		]}`, 2},
+	} {
+		result, err := InterpretGedcomX([]byte(c.gedcomXJson))
+		if err != nil {
+			t.Errorf("failed to interpret GEDCOM X %s with error: %s", c.gedcomXJson, err)
+			continue
+		}
+		if len(result.Individuals) != c.expectedIndividuals {
+			t.Errorf("expected %d individuals from GEDCOM X %s, found %v", c.expectedIndividuals, c.gedcomXJson, result.Individuals)
+		}
+	}
+}
+
+func TestGedcomXChildInTwoFamilies(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"0 @I1@ INDI",
+		"1 SEX M",
+		"1 FAMS @F1@",
+		"0 @I2@ INDI",
+		"1 SEX F",
+		"1 FAMS @F1@",
+		"0 @I3@ INDI",
+		"1 SEX M",
+		"1 FAMS @F2@",
+		"0 @I4@ INDI",
+		"1 SEX F",
+		"1 FAMS @F2@",
+		"0 @I5@ INDI",
+		"1 FAMC @F1@",
+		"2 PEDI birth",
+		"1 FAMC @F2@",
+		"2 PEDI adopted",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"1 WIFE @I2@",
+		"1 CHIL @I5@",
+		"0 @F2@ FAM",
+		"1 HUSB @I3@",
+		"1 WIFE @I4@",
+		"1 CHIL @I5@",
+		"0 TRLR",
+	})
+	output, err := g.ToGedcomX(nil)
+	if err != nil {
+		t.Fatalf("failed to write GEDCOM X with error: %s", err)
+	}
+	result, err := InterpretGedcomX(*output)
+	if err != nil {
+		t.Fatalf("failed to interpret GEDCOM X with error: %s", err)
+	}
+
+	if len(result.Families) != 2 {
+		t.Fatalf("expected 2 families, found %v", result.Families)
+	}
+	families := result.FamiliesByIds()
+	for id, expected := range map[string]*Gedcom_Family{
+		"@F1@": {Id: "@F1@", FatherId: "@I1@", MotherId: "@I2@", ChildIds: []string{"@I5@"}},
+		"@F2@": {Id: "@F2@", FatherId: "@I3@", MotherId: "@I4@", ChildIds: []string{"@I5@"}},
+	} {
+		if !proto.Equal(families[id], expected) {
+			t.Errorf("expected family %v, found %v", expected, families[id])
+		}
+	}
+	links := result.IndividualsByIds()["@I5@"].GetChildToFamilyLinks()
+	sort.Slice(links, func(i, j int) bool { return links[i].FamilyId < links[j].FamilyId })
+	expectedLinks := []*Gedcom_Individual_FamilyLink{{FamilyId: "@F1@", Pedigree: "birth"}, {FamilyId: "@F2@", Pedigree: "adopted"}}
+	if len(links) != len(expectedLinks) || !proto.Equal(links[0], expectedLinks[0]) || !proto.Equal(links[1], expectedLinks[1]) {
+		t.Errorf("expected child to family links %v, found %v", expectedLinks, links)
+	}
+}
@@ -0,0 +1,527 @@
+package gedcom
+
+// grammar of the GEDCOM 5.5.1 lineage-linked form, used to check the conformance of files.
+// Structures are identified by name, most of them by the tag they start with in their usual context.
+// User-defined tags, starting with an underscore, are allowed anywhere along with everything subordinate to them.
+
+type conformanceValueKind int
+
+const (
+	valueNone conformanceValueKind = iota
+	valueText
+	valuePointer
+	valuePointerOrText
+	valueEnum
+)
+
+type conformanceValue struct {
+	kind      conformanceValueKind
+	required  bool
+	maxLength int
+	pointerTo string
+	enum      []string
+}
+
+type conformanceSubordinate struct {
+	structure string
+	min       int
+	max       int // 0 for unbounded
+}
+
+type conformanceStructure struct {
+	value        conformanceValue
+	subordinates map[string]conformanceSubordinate
+}
+
+func noValue() conformanceValue {
+	return conformanceValue{kind: valueNone}
+}
+
+func text(maxLength int) conformanceValue {
+	return conformanceValue{kind: valueText, maxLength: maxLength}
+}
+
+func requiredText(maxLength int) conformanceValue {
+	return conformanceValue{kind: valueText, required: true, maxLength: maxLength}
+}
+
+func pointer(recordTag string) conformanceValue {
+	return conformanceValue{kind: valuePointer, required: true, pointerTo: recordTag}
+}
+
+func pointerOrText(recordTag string, maxLength int) conformanceValue {
+	return conformanceValue{kind: valuePointerOrText, pointerTo: recordTag, maxLength: maxLength}
+}
+
+func enum(values ...string) conformanceValue {
+	return conformanceValue{kind: valueEnum, required: true, enum: values}
+}
+
+func optionalEnum(values ...string) conformanceValue {
+	return conformanceValue{kind: valueEnum, enum: values}
+}
+
+func one(structure string) conformanceSubordinate {
+	return conformanceSubordinate{structure: structure, min: 1, max: 1}
+}
+
+func optional(structure string) conformanceSubordinate {
+	return conformanceSubordinate{structure: structure, min: 0, max: 1}
+}
+
+func many(structure string) conformanceSubordinate {
+	return conformanceSubordinate{structure: structure, min: 0, max: 0}
+}
+
+func atLeastOne(structure string) conformanceSubordinate {
+	return conformanceSubordinate{structure: structure, min: 1, max: 0}
+}
+
+func upTo(structure string, max int) conformanceSubordinate {
+	return conformanceSubordinate{structure: structure, min: 0, max: max}
+}
+
+type subordinates map[string]conformanceSubordinate
+
+// with returns the union of the given sets of subordinates, later sets taking precedence
+func with(sets ...subordinates) subordinates {
+	result := subordinates{}
+	for _, set := range sets {
+		for tag, subordinate := range set {
+			result[tag] = subordinate
+		}
+	}
+	return result
+}
+
+// structure names by the tag of the records they describe
+var conformanceRecordStructures = map[string]string{
+	"HEAD":              "HEAD",
+	familyRecordTag:     "FAM_RECORD",
+	individualRecordTag: "INDI_RECORD",
+	multimediaRecordTag: "OBJE_RECORD",
+	noteRecordTag:       "NOTE_RECORD",
+	repositoryRecordTag: "REPO_RECORD",
+	sourceRecordTag:     "SOUR_RECORD",
+	submitterRecordTag:  "SUBM_RECORD",
+	submissionRecordTag: "SUBN_RECORD",
+	"TRLR":              "TRLR",
+}
+
+var (
+	continuation = subordinates{
+		"CONT": many("CONT"),
+		"CONC": many("CONC"),
+	}
+	addressStructure = subordinates{
+		"ADDR":  optional("ADDR"),
+		"PHON":  upTo("PHON", 3),
+		"EMAIL": upTo("EMAIL", 3),
+		"FAX":   upTo("FAX", 3),
+		"WWW":   upTo("WWW", 3),
+	}
+	notesSourcesAndMultimedia = subordinates{
+		"NOTE": many("NOTE"),
+		"SOUR": many("SOUR"),
+		"OBJE": many("OBJE"),
+	}
+	recordIdentificationSubordinates = subordinates{
+		"REFN": many("REFN"),
+		"RIN":  optional("RIN"),
+		"CHAN": optional("CHAN"),
+	}
+	eventDetail = with(addressStructure, notesSourcesAndMultimedia, subordinates{
+		"TYPE": optional("EVENT_TYPE"),
+		"DATE": optional("DATE"),
+		"PLAC": optional("PLAC"),
+		"AGNC": optional("AGNC"),
+		"RELI": optional("RELI"),
+		"CAUS": optional("CAUS"),
+		"RESN": optional("RESN"),
+	})
+	individualEventDetail = with(eventDetail, subordinates{
+		"AGE": optional("AGE"),
+	})
+	familyEventDetail = with(eventDetail, subordinates{
+		"HUSB": optional("EVENT_SPOUSE"),
+		"WIFE": optional("EVENT_SPOUSE"),
+	})
+	ldsOrdinance = subordinates{
+		"DATE": optional("DATE"),
+		"TEMP": optional("TEMP"),
+		"PLAC": optional("LDS_PLAC"),
+		"STAT": optional("LDS_STAT"),
+		"NOTE": many("NOTE"),
+		"SOUR": many("SOUR"),
+	}
+	personalNamePieces = subordinates{
+		"NPFX": optional("NAME_PIECE"),
+		"GIVN": optional("NAME_PIECE"),
+		"NICK": optional("NAME_PIECE"),
+		"SPFX": optional("NAME_PIECE"),
+		"SURN": optional("NAME_PIECE"),
+		"NSFX": optional("NAME_PIECE"),
+		"NOTE": many("NOTE"),
+		"SOUR": many("SOUR"),
+	}
+)
+
+var individualEventTags = []string{
+	"BIRT", "CHR", "DEAT", "BURI", "CREM", "ADOP", "BAPM", "BARM", "BASM", "BLES", "CHRA", "CONF", "FCOM",
+	"ORDN", "NATU", "EMIG", "IMMI", "CENS", "PROB", "WILL", "GRAD", "RETI", "EVEN",
+}
+
+var individualAttributeTags = []string{
+	"CAST", "DSCR", "EDUC", "IDNO", "NATI", "NCHI", "NMR", "OCCU", "PROP", "RELI", "RESI", "SSN", "TITL", "FACT",
+}
+
+var familyEventTags = []string{
+	"ANUL", "CENS", "DIV", "DIVF", "ENGA", "MARB", "MARC", "MARR", "MARL", "MARS", "RESI", "EVEN",
+}
+
+var conformanceGrammar = buildConformanceGrammar()
+
+func buildConformanceGrammar() map[string]*conformanceStructure {
+	g := map[string]*conformanceStructure{
+		// header
+		"HEAD": {noValue(), subordinates{
+			"SOUR": one("HEAD.SOUR"),
+			"DEST": optional("HEAD.DEST"),
+			"DATE": optional("HEAD.DATE"),
+			"SUBM": one("HEAD.SUBM"),
+			"SUBN": optional("HEAD.SUBN"),
+			"FILE": optional("HEAD.FILE"),
+			"COPR": optional("HEAD.COPR"),
+			"GEDC": one("HEAD.GEDC"),
+			"CHAR": one("HEAD.CHAR"),
+			"LANG": optional("HEAD.LANG"),
+			"PLAC": optional("HEAD.PLAC"),
+			"NOTE": optional("HEAD.NOTE"),
+		}},
+		"HEAD.SOUR": {requiredText(20), subordinates{
+			"VERS": optional("HEAD.SOUR.VERS"),
+			"NAME": optional("HEAD.SOUR.NAME"),
+			"CORP": optional("HEAD.SOUR.CORP"),
+			"DATA": optional("HEAD.SOUR.DATA"),
+		}},
+		"HEAD.SOUR.VERS": {requiredText(15), nil},
+		"HEAD.SOUR.NAME": {requiredText(90), nil},
+		"HEAD.SOUR.CORP": {requiredText(90), addressStructure},
+		"HEAD.SOUR.DATA": {requiredText(90), subordinates{
+			"DATE": optional("DATE"),
+			"COPR": optional("HEAD.SOUR.DATA.COPR"),
+		}},
+		"HEAD.SOUR.DATA.COPR": {text(248), continuation},
+		"HEAD.DEST":           {requiredText(20), nil},
+		"HEAD.DATE": {requiredText(11), subordinates{
+			"TIME": optional("TIME"),
+		}},
+		"HEAD.SUBM": {pointer(submitterRecordTag), nil},
+		"HEAD.SUBN": {pointer(submissionRecordTag), nil},
+		"HEAD.FILE": {requiredText(90), nil},
+		"HEAD.COPR": {requiredText(90), nil},
+		"HEAD.GEDC": {noValue(), subordinates{
+			"VERS": one("HEAD.GEDC.VERS"),
+			"FORM": one("HEAD.GEDC.FORM"),
+		}},
+		"HEAD.GEDC.VERS": {enum("5.5.1"), nil},
+		"HEAD.GEDC.FORM": {enum("LINEAGE-LINKED"), nil},
+		"HEAD.CHAR": {enum("ANSEL", "UTF-8", "UNICODE", "ASCII"), subordinates{
+			"VERS": optional("HEAD.SOUR.VERS"),
+		}},
+		"HEAD.LANG": {requiredText(15), nil},
+		"HEAD.PLAC": {noValue(), subordinates{
+			"FORM": one("PLAC.FORM"),
+		}},
+		"HEAD.NOTE": {text(248), continuation},
+		"TRLR":      {noValue(), subordinates{}},
+
+		// records
+		"FAM_RECORD": {noValue(), with(notesSourcesAndMultimedia, recordIdentificationSubordinates, subordinates{
+			"RESN": optional("RESN"),
+			"HUSB": optional("FAM.HUSB"),
+			"WIFE": optional("FAM.WIFE"),
+			"CHIL": many("FAM.CHIL"),
+			"NCHI": optional("NCHI"),
+			"SUBM": many("SUBM"),
+			"SLGS": many("SLGS"),
+		})},
+		"INDI_RECORD": {noValue(), with(notesSourcesAndMultimedia, recordIdentificationSubordinates, subordinates{
+			"RESN": optional("RESN"),
+			"NAME": many("NAME"),
+			"SEX":  optional("SEX"),
+			"BAPL": many("BAPL"),
+			"CONL": many("BAPL"),
+			"ENDL": many("BAPL"),
+			"SLGC": many("SLGC"),
+			"FAMC": many("FAMC"),
+			"FAMS": many("FAMS"),
+			"SUBM": many("SUBM"),
+			"ASSO": many("ASSO"),
+			"ALIA": many("ALIA"),
+			"ANCI": many("SUBM"),
+			"DESI": many("SUBM"),
+			"RFN":  optional("RFN"),
+			"AFN":  optional("AFN"),
+		})},
+		"OBJE_RECORD": {noValue(), with(recordIdentificationSubordinates, subordinates{
+			"FILE": atLeastOne("OBJE_RECORD.FILE"),
+			"NOTE": many("NOTE"),
+			"SOUR": many("SOUR"),
+		})},
+		"OBJE_RECORD.FILE": {requiredText(30), subordinates{
+			"FORM": one("OBJE_RECORD.FILE.FORM"),
+			"TITL": optional("OBJE.TITL"),
+		}},
+		"OBJE_RECORD.FILE.FORM": {requiredText(4), subordinates{
+			"TYPE": optional("MEDI"),
+		}},
+		"NOTE_RECORD": {text(248), with(continuation, recordIdentificationSubordinates, subordinates{
+			"SOUR": many("SOUR"),
+		})},
+		"REPO_RECORD": {noValue(), with(addressStructure, recordIdentificationSubordinates, subordinates{
+			"NAME": one("REPO_RECORD.NAME"),
+			"NOTE": many("NOTE"),
+		})},
+		"REPO_RECORD.NAME": {requiredText(90), nil},
+		"SOUR_RECORD": {noValue(), with(recordIdentificationSubordinates, subordinates{
+			"DATA": optional("SOUR_RECORD.DATA"),
+			"AUTH": optional("LONG_TEXT"),
+			"TITL": optional("LONG_TEXT"),
+			"ABBR": optional("SOUR_RECORD.ABBR"),
+			"PUBL": optional("LONG_TEXT"),
+			"TEXT": optional("LONG_TEXT"),
+			"REPO": many("SOUR_RECORD.REPO"),
+			"NOTE": many("NOTE"),
+			"OBJE": many("OBJE"),
+		})},
+		"SOUR_RECORD.DATA": {noValue(), subordinates{
+			"EVEN": many("SOUR_RECORD.DATA.EVEN"),
+			"AGNC": optional("AGNC"),
+			"NOTE": many("NOTE"),
+		}},
+		"SOUR_RECORD.DATA.EVEN": {requiredText(90), subordinates{
+			"DATE": optional("DATE"),
+			"PLAC": optional("LDS_PLAC"),
+		}},
+		"SOUR_RECORD.ABBR": {requiredText(60), nil},
+		"SOUR_RECORD.REPO": {pointerOrText(repositoryRecordTag, 0), subordinates{
+			"NOTE": many("NOTE"),
+			"CALN": many("CALN"),
+		}},
+		"CALN": {requiredText(120), subordinates{
+			"MEDI": optional("MEDI"),
+		}},
+		"SUBM_RECORD": {noValue(), with(addressStructure, recordIdentificationSubordinates, subordinates{
+			"NAME": one("SUBM_RECORD.NAME"),
+			"OBJE": many("OBJE"),
+			"LANG": upTo("SUBM_RECORD.LANG", 3),
+			"RFN":  optional("RFN"),
+			"NOTE": many("NOTE"),
+		})},
+		"SUBM_RECORD.NAME": {requiredText(60), nil},
+		"SUBM_RECORD.LANG": {requiredText(90), nil},
+		"SUBN_RECORD": {noValue(), subordinates{
+			"SUBM": optional("SUBM"),
+			"FAMF": optional("SUBN_RECORD.FAMF"),
+			"TEMP": optional("TEMP"),
+			"ANCE": optional("SUBN_RECORD.GENERATIONS"),
+			"DESC": optional("SUBN_RECORD.GENERATIONS"),
+			"ORDI": optional("SUBN_RECORD.ORDI"),
+			"RIN":  optional("RIN"),
+			"NOTE": many("NOTE"),
+			"CHAN": optional("CHAN"),
+		}},
+		"SUBN_RECORD.FAMF":        {requiredText(120), nil},
+		"SUBN_RECORD.GENERATIONS": {requiredText(4), nil},
+		"SUBN_RECORD.ORDI":        {enum("yes", "no"), nil},
+
+		// family record substructures
+		"FAM.HUSB":     {pointer(individualRecordTag), nil},
+		"FAM.WIFE":     {pointer(individualRecordTag), nil},
+		"FAM.CHIL":     {pointer(individualRecordTag), nil},
+		"NCHI":         {requiredText(3), individualEventDetail},
+		"EVENT_SPOUSE": {noValue(), subordinates{"AGE": one("AGE")}},
+		"SLGS":         {noValue(), ldsOrdinance},
+
+		// individual record substructures
+		"NAME": {requiredText(120), with(personalNamePieces, subordinates{
+			"TYPE": optional("NAME.TYPE"),
+			"FONE": many("NAME_VARIATION"),
+			"ROMN": many("NAME_VARIATION"),
+		})},
+		"NAME.TYPE": {requiredText(30), nil},
+		"NAME_VARIATION": {requiredText(120), with(personalNamePieces, subordinates{
+			"TYPE": one("NAME.TYPE"),
+		})},
+		"NAME_PIECE": {requiredText(120), nil},
+		"SEX":        {enum("M", "F", "U"), nil},
+		"BAPL":       {noValue(), ldsOrdinance},
+		"SLGC": {noValue(), with(ldsOrdinance, subordinates{
+			"FAMC": one("SLGC.FAMC"),
+		})},
+		"SLGC.FAMC": {pointer(familyRecordTag), nil},
+		"FAMC": {pointer(familyRecordTag), subordinates{
+			"PEDI": optional("PEDI"),
+			"STAT": optional("FAMC.STAT"),
+			"NOTE": many("NOTE"),
+		}},
+		"PEDI":      {enum("adopted", "birth", "foster", "sealing"), nil},
+		"FAMC.STAT": {enum("challenged", "disproven", "proven"), nil},
+		"FAMS": {pointer(familyRecordTag), subordinates{
+			"NOTE": many("NOTE"),
+		}},
+		"SUBM": {pointer(submitterRecordTag), nil},
+		"ASSO": {pointer(individualRecordTag), subordinates{
+			"RELA": one("ASSO.RELA"),
+			"SOUR": many("SOUR"),
+			"NOTE": many("NOTE"),
+		}},
+		"ASSO.RELA":  {requiredText(25), nil},
+		"ALIA":       {pointer(individualRecordTag), nil},
+		"RFN":        {requiredText(90), nil},
+		"AFN":        {requiredText(12), nil},
+		"INDI_EVENT": {optionalEnum("Y"), individualEventDetail},
+		"BIRT": {optionalEnum("Y"), with(individualEventDetail, subordinates{
+			"FAMC": optional("EVENT.FAMC"),
+		})},
+		"ADOP": {optionalEnum("Y"), with(individualEventDetail, subordinates{
+			"FAMC": optional("ADOP.FAMC"),
+		})},
+		"EVENT.FAMC": {pointer(familyRecordTag), nil},
+		"ADOP.FAMC": {pointer(familyRecordTag), subordinates{
+			"ADOP": optional("ADOP.FAMC.ADOP"),
+		}},
+		"ADOP.FAMC.ADOP": {enum("HUSB", "WIFE", "BOTH"), nil},
+		"EVEN":           {text(90), individualEventDetail},
+		"INDI_ATTRIBUTE": {requiredText(248), individualEventDetail},
+		"RESI":           {noValue(), individualEventDetail},
+		"FAM_EVENT":      {optionalEnum("Y"), familyEventDetail},
+		"FAM.EVEN":       {text(90), familyEventDetail},
+
+		// shared substructures
+		"CONT":      {text(248), nil},
+		"CONC":      {text(248), nil},
+		"LONG_TEXT": {text(248), continuation},
+		"RIN":       {requiredText(12), nil},
+		"REFN": {requiredText(20), subordinates{
+			"TYPE": optional("REFN.TYPE"),
+		}},
+		"REFN.TYPE": {requiredText(40), nil},
+		"CHAN": {noValue(), subordinates{
+			"DATE": one("CHAN.DATE"),
+			"NOTE": many("NOTE"),
+		}},
+		"CHAN.DATE": {requiredText(11), subordinates{
+			"TIME": optional("TIME"),
+		}},
+		"TIME":       {requiredText(12), nil},
+		"DATE":       {requiredText(35), nil},
+		"AGE":        {requiredText(12), nil},
+		"AGNC":       {requiredText(120), nil},
+		"RELI":       {requiredText(90), nil},
+		"CAUS":       {requiredText(90), nil},
+		"EVENT_TYPE": {requiredText(90), nil},
+		"RESN":       {enum(RestrictionConfidential, RestrictionLocked, RestrictionPrivacy), nil},
+		"TEMP":       {requiredText(5), nil},
+		"LDS_PLAC":   {requiredText(120), nil},
+		"LDS_STAT": {requiredText(10), subordinates{
+			"DATE": one("CHAN.DATE"),
+		}},
+		"PLAC": {requiredText(120), subordinates{
+			"FORM": optional("PLAC.FORM"),
+			"FONE": many("PLAC.VARIATION"),
+			"ROMN": many("PLAC.VARIATION"),
+			"MAP":  optional("PLAC.MAP"),
+			"NOTE": many("NOTE"),
+		}},
+		"PLAC.FORM": {requiredText(120), nil},
+		"PLAC.VARIATION": {requiredText(120), subordinates{
+			"TYPE": one("NAME.TYPE"),
+		}},
+		"PLAC.MAP": {noValue(), subordinates{
+			"LATI": one("PLAC.MAP.COORDINATE"),
+			"LONG": one("PLAC.MAP.COORDINATE"),
+		}},
+		"PLAC.MAP.COORDINATE": {requiredText(10), nil},
+		"ADDR": {text(60), with(continuation, subordinates{
+			"ADR1": optional("ADDRESS_LINE"),
+			"ADR2": optional("ADDRESS_LINE"),
+			"ADR3": optional("ADDRESS_LINE"),
+			"CITY": optional("ADDRESS_LINE"),
+			"STAE": optional("ADDRESS_LINE"),
+			"POST": optional("ADDR.POST"),
+			"CTRY": optional("ADDRESS_LINE"),
+		})},
+		"ADDRESS_LINE": {requiredText(60), nil},
+		"ADDR.POST":    {requiredText(10), nil},
+		"PHON":         {requiredText(25), nil},
+		"EMAIL":        {requiredText(120), nil},
+		"FAX":          {requiredText(60), nil},
+		"WWW":          {requiredText(120), nil},
+		"NOTE":         {pointerOrText(noteRecordTag, 248), continuation},
+		"SOUR": {pointerOrText(sourceRecordTag, 248), with(continuation, subordinates{
+			"PAGE": optional("SOUR.PAGE"),
+			"EVEN": optional("SOUR.EVEN"),
+			"DATA": optional("SOUR.DATA"),
+			"TEXT": many("LONG_TEXT"),
+			"QUAY": optional("QUAY"),
+			"OBJE": many("OBJE"),
+			"NOTE": many("NOTE"),
+		})},
+		"SOUR.PAGE": {requiredText(248), nil},
+		"SOUR.EVEN": {requiredText(15), subordinates{
+			"ROLE": optional("SOUR.EVEN.ROLE"),
+		}},
+		"SOUR.EVEN.ROLE": {requiredText(15), nil},
+		"SOUR.DATA": {noValue(), subordinates{
+			"DATE": optional("DATE"),
+			"TEXT": many("LONG_TEXT"),
+		}},
+		"QUAY": {enum("0", "1", "2", "3"), nil},
+		"OBJE": {pointerOrText(multimediaRecordTag, 0), subordinates{
+			"FILE": many("OBJE.FILE"),
+			"TITL": optional("OBJE.TITL"),
+		}},
+		"OBJE.FILE": {requiredText(30), subordinates{
+			"FORM": one("OBJE.FILE.FORM"),
+		}},
+		"OBJE.FILE.FORM": {requiredText(4), subordinates{
+			"MEDI": optional("MEDI"),
+		}},
+		"OBJE.TITL": {requiredText(248), nil},
+		"MEDI":      {requiredText(15), nil},
+	}
+
+	individual := g["INDI_RECORD"].subordinates
+	for _, tag := range individualEventTags {
+		switch tag {
+		case "BIRT", "CHR":
+			individual[tag] = many("BIRT")
+		case "ADOP", "EVEN":
+			individual[tag] = many(tag)
+		default:
+			individual[tag] = many("INDI_EVENT")
+		}
+	}
+	for _, tag := range individualAttributeTags {
+		switch tag {
+		case "RESI", "NCHI":
+			individual[tag] = many(tag)
+		default:
+			individual[tag] = many("INDI_ATTRIBUTE")
+		}
+	}
+	family := g["FAM_RECORD"].subordinates
+	for _, tag := range familyEventTags {
+		switch tag {
+		case "EVEN":
+			family[tag] = many("FAM.EVEN")
+		case "RESI":
+			family[tag] = many("RESI")
+		default:
+			family[tag] = many("FAM_EVENT")
+		}
+	}
+	return g
+}
@@ -0,0 +1,103 @@
+package gedcom
+
+import (
+	"strings"
+)
+
+func interpretHeaderSourceStructure(sourceLines []*Line) *Gedcom_HeaderType_SourceSystemType {
+	sourceSystem := &Gedcom_HeaderType_SourceSystemType{}
+	forEachSubordinateLine(sourceLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "VERS":
+			sourceSystem.Version = subordinateLines[0].Value()
+		case "NAME":
+			sourceSystem.ProductName = subordinateLines[0].Value()
+		case "CORP":
+			sourceSystem.Corporation = interpretHeaderCorporationStructure(subordinateLines)
+		case "DATA":
+			sourceSystem.Data = interpretHeaderSourceDataStructure(subordinateLines)
+		}
+	})
+	return sourceSystem
+}
+
+func interpretHeaderCorporationStructure(corporationLines []*Line) *Gedcom_HeaderType_SourceSystemType_CorporationType {
+	return &Gedcom_HeaderType_SourceSystemType_CorporationType{
+		Name:    corporationLines[0].Value(),
+		Address: interpretAddressStructure(corporationLines),
+	}
+}
+
+func interpretHeaderSourceDataStructure(dataLines []*Line) *Gedcom_HeaderType_SourceSystemType_DataType {
+	data := &Gedcom_HeaderType_SourceSystemType_DataType{
+		Name: dataLines[0].Value(),
+	}
+	forEachSubordinateLine(dataLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "DATE":
+			date := interpretDateStructure(subordinateLines[0])
+			gedcomIndividualDate := date.toGedcomIndividualDate()
+			data.Date = &gedcomIndividualDate
+		case "COPR":
+			data.Copyright = interpretTextStructure(subordinateLines)
+		}
+	})
+	return data
+}
+
+func interpretHeaderGedcomMetaDataStructure(gedcomMetaDataLines []*Line) *Gedcom_HeaderType_GedcomMetaDataType {
+	gedcomMetaData := &Gedcom_HeaderType_GedcomMetaDataType{}
+	forEachSubordinateLine(gedcomMetaDataLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "VERS":
+			gedcomMetaData.VersionNumber = subordinateLines[0].Value()
+		case "FORM":
+			gedcomMetaData.GedcomForm = subordinateLines[0].Value()
+		}
+	})
+	return gedcomMetaData
+}
+
+// interpretHeaderDateStructure interprets the transmission date of a header along with its optional time
+func interpretHeaderDateStructure(dateLines []*Line, header *Gedcom_HeaderType) {
+	date := interpretDateStructure(dateLines[0])
+	gedcomIndividualDate := date.toGedcomIndividualDate()
+	header.TransmissionDate = &gedcomIndividualDate
+	forEachSubordinateLine(dateLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "TIME":
+			header.TransmissionTime = subordinateLines[0].Value()
+		}
+	})
+}
+
+func interpretHeaderPlaceStructure(placeLines []*Line) string {
+	placeForm := ""
+	forEachSubordinateLine(placeLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "FORM":
+			placeForm = subordinateLines[0].Value()
+		}
+	})
+	return placeForm
+}
+
+// interpretHeaderSchemaStructure interprets the GEDCOM 7.0 extension tag declarations (SCHMA),
+// which map the extension tags used in a file to the URIs defining them
+func interpretHeaderSchemaStructure(schemaLines []*Line) []*Gedcom_HeaderType_ExtensionTag {
+	var extensionTags []*Gedcom_HeaderType_ExtensionTag
+	forEachSubordinateLine(schemaLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "TAG":
+			parts := strings.SplitN(subordinateLines[0].Value(), " ", 2)
+			extensionTag := &Gedcom_HeaderType_ExtensionTag{
+				Tag: parts[0],
+			}
+			if len(parts) > 1 {
+				extensionTag.Uri = strings.TrimSpace(parts[1])
+			}
+			extensionTags = append(extensionTags, extensionTag)
+		}
+	})
+	return extensionTags
+}
@@ -0,0 +1,96 @@
+package gedcom
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+var headerLines = []string{
+	"0 HEAD",
+	"1 SOUR FTM",
+	"2 VERS Family Tree Maker (17.0.0.559)",
+	"2 NAME Family Tree Maker for Windows",
+	"2 CORP The Generations Network",
+	"2 DATA Harry Potter Lexicon",
+	"3 DATE 1 JAN 2007",
+	"3 COPR Copyright of the",
+	"4 CONT source data",
+	"1 DEST FTM",
+	"1 DATE 11 DEC 2007",
+	"2 TIME 13:04:05",
+	"1 SUBM @SUBM@",
+	"1 SUBN @SUBN@",
+	"1 FILE Harry-Potter-Family-Tree_2007-12-12.ged",
+	"1 COPR Copyright of the transmission",
+	"1 GEDC",
+	"2 VERS 5.5.1",
+	"2 FORM LINEAGE-LINKED",
+	"1 CHAR ANSI",
+	"1 LANG English",
+	"1 PLAC",
+	"2 FORM City, County, State, Country",
+	"1 NOTE A family tree of ",
+	"2 CONC the Harry Potter universe",
+	"2 CONT spanning multiple generations",
+}
+
+var expectedHeader = &Gedcom_HeaderType{
+	Source: "FTM",
+	SourceSystem: &Gedcom_HeaderType_SourceSystemType{
+		Version:     "Family Tree Maker (17.0.0.559)",
+		ProductName: "Family Tree Maker for Windows",
+		Corporation: &Gedcom_HeaderType_SourceSystemType_CorporationType{
+			Name: "The Generations Network",
+		},
+		Data: &Gedcom_HeaderType_SourceSystemType_DataType{
+			Name:      "Harry Potter Lexicon",
+			Date:      &Gedcom_Individual_Date{Year: "2007", Month: "01", Day: "1"},
+			Copyright: "Copyright of the\nsource data",
+		},
+	},
+	Destination:      "FTM",
+	TransmissionDate: &Gedcom_Individual_Date{Year: "2007", Month: "12", Day: "11"},
+	TransmissionTime: "13:04:05",
+	Submitter:        "@SUBM@",
+	Submission:       "@SUBN@",
+	FileName:         "Harry-Potter-Family-Tree_2007-12-12.ged",
+	Copyright:        "Copyright of the transmission",
+	GedcomMetaData: &Gedcom_HeaderType_GedcomMetaDataType{
+		VersionNumber: "5.5.1",
+		GedcomForm:    "LINEAGE-LINKED",
+	},
+	CharacterSet: "ANSI",
+	Language:     "English",
+	PlaceForm:    "City, County, State, Country",
+	Note:         "A family tree of the Harry Potter universe\nspanning multiple generations",
+}
+
+func TestInterpretHeader(t *testing.T) {
+	g := NewConcurrencySafeGedcom()
+	err := g.InterpretHeader(recordLines(headerLines))
+	if err != nil {
+		t.Fatalf("failed to interpret header with error: %s", err)
+	}
+	if !proto.Equal(g.Header, expectedHeader) {
+		t.Errorf("result header does not equal expected; result: %+v, expected %+v", g.Header, expectedHeader)
+	}
+}
+
+func TestSerializeHeader(t *testing.T) {
+	g := NewConcurrencySafeGedcom()
+	g.Header = expectedHeader
+	buf, err := g.ToSerializedGedcom(nil)
+	if err != nil {
+		t.Fatalf("failed to serialize header with error: %s", err)
+	}
+
+	reinterpreted := NewConcurrencySafeGedcom()
+	err = reinterpreted.InterpretHeader(recordLines(splitLines(buf.String())))
+	if err != nil {
+		t.Fatalf("failed to interpret serialized header with error: %s", err)
+	}
+	if !proto.Equal(reinterpreted.Header, expectedHeader) {
+		t.Errorf("serialized header does not equal original; result: %+v, expected %+v", reinterpreted.Header, expectedHeader)
+	}
+}
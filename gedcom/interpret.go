@@ -14,41 +14,46 @@ func (g *ConcurrencySafeGedcom) InterpretHeader(headerLines []*Line) error {
 		if err != nil || tag != "HEAD" {
 			continue // search lines until HEAD is found
 		}
-		for j, deepHeaderLine := range headerLines[i+1:] {
-			tag, err := deepHeaderLine.Tag()
-			if err != nil {
-				continue
-			}
+		forEachSubordinateLine(headerLines[i:], func(tag string, subordinateLines []*Line) {
 			switch tag {
 			case "SOUR":
-				h.Source = deepHeaderLine.Value()
+				h.Source = subordinateLines[0].Value()
+				h.SourceSystem = interpretHeaderSourceStructure(subordinateLines)
+			case "DEST":
+				h.Destination = subordinateLines[0].Value()
+			case "DATE":
+				interpretHeaderDateStructure(subordinateLines, h)
 			case "SUBM":
-				h.Submitter = deepHeaderLine.Value()
-			case "GEDC": // TODO: extract to function
-				gedcomMetaData := &Gedcom_HeaderType_GedcomMetaDataType{
-					VersionNumber: deepHeaderLine.Value(),
-				}
-				for _, deepGedcomMetaDataLine := range headerLines[j+1:] {
-					tag, err := deepGedcomMetaDataLine.Tag()
-					if err != nil {
-						continue
-					}
-					switch tag {
-					case "VERS":
-						gedcomMetaData.VersionNumber = deepGedcomMetaDataLine.Value()
-					case "FORM":
-						gedcomMetaData.GedcomForm = deepGedcomMetaDataLine.Value()
-					}
-				}
-				h.GedcomMetaData = gedcomMetaData
+				h.Submitter = subordinateLines[0].Value()
+			case "SUBN":
+				h.Submission = subordinateLines[0].Value()
+			case "FILE":
+				h.FileName = subordinateLines[0].Value()
+			case "COPR":
+				h.Copyright = interpretTextStructure(subordinateLines)
+			case "GEDC":
+				h.GedcomMetaData = interpretHeaderGedcomMetaDataStructure(subordinateLines)
 			case "CHAR":
-				h.CharacterSet = deepHeaderLine.Value()
+				h.CharacterSet = subordinateLines[0].Value()
+			case "LANG":
+				h.Language = subordinateLines[0].Value()
+			case "PLAC":
+				h.PlaceForm = interpretHeaderPlaceStructure(subordinateLines)
+			case "NOTE":
+				h.Note = interpretTextStructure(subordinateLines)
+			case "SCHMA", "_SCHMA":
+				h.ExtensionTags = interpretHeaderSchemaStructure(subordinateLines)
 			}
-		}
+		})
 		break
 	}
+	if isGedcom7(h) && h.CharacterSet == "" {
+		// GEDCOM 7.0 files are always encoded in UTF-8 and don't declare their character set
+		h.CharacterSet = "UTF-8"
+	}
 	g.lock()
 	g.Header = h
+	g.handleRecord(h)
 	g.unlock()
 	return nil
 }
@@ -64,7 +69,7 @@ func (g *ConcurrencySafeGedcom) InterpretHeader(headerLines []*Line) error {
 //
 // * MULTIMEDIA_RECORD (OBJE)
 //
-// * NOTE_RECORD (NOTE)
+// * NOTE_RECORD (NOTE), or SHARED_NOTE_RECORD (SNOTE) in GEDCOM 7.0
 //
 // * REPOSITORY_RECORD (REPO)
 //
@@ -72,7 +77,9 @@ func (g *ConcurrencySafeGedcom) InterpretHeader(headerLines []*Line) error {
 //
 // * SUBMITTER_RECORD (SUBM)
 //
+// * SUBMISSION_RECORD (SUBN)
 func (g *ConcurrencySafeGedcom) InterpretRecord(recordLines []*Line, waitGroup *sync.WaitGroup) {
+	defer waitGroup.Done()
 	tag, err := recordLines[0].Tag()
 	if err != nil {
 		return
@@ -83,8 +90,8 @@ func (g *ConcurrencySafeGedcom) InterpretRecord(recordLines []*Line, waitGroup *
 	case "INDI":
 		g.interpretIndividualRecord(recordLines)
 	case "OBJE":
-		// TODO
-	case "NOTE":
+		g.interpretMultimediaRecord(recordLines)
+	case "NOTE", "SNOTE":
 		g.interpretNoteRecord(recordLines)
 	case "REPO":
 		g.interpretRepositoryRecord(recordLines)
@@ -92,45 +99,66 @@ func (g *ConcurrencySafeGedcom) InterpretRecord(recordLines []*Line, waitGroup *
 		g.interpretSourceRecord(recordLines)
 	case "SUBM":
 		g.interpretSubmitterRecord(recordLines)
+	case "SUBN":
+		g.interpretSubmissionRecord(recordLines)
 	}
-	waitGroup.Done()
 }
 
 func (g *ConcurrencySafeGedcom) interpretIndividualRecord(recordLines []*Line) {
 	individualXRefID := recordLines[0].XRefID()
+	identification := interpretRecordIdentification(recordLines)
+	links := interpretStructureLinks(recordLines)
 	individualInstance := Gedcom_Individual{
-		Id: individualXRefID,
+		Id:                individualXRefID,
+		UserReferences:    identification.userReferences,
+		AutomatedRecordId: identification.automatedRecordId,
+		UniqueIds:         identification.uniqueIds,
+		ChangeDate:        identification.changeDate,
+		ExternalIds:       identification.externalIds,
+		SourceCitations:   links.sourceCitations,
+		Notes:             links.notes,
+		MultimediaLinks:   links.multimediaLinks,
 	}
-	rootLevel, err := recordLines[0].Level()
-	if err != nil {
-		return
-	}
-	for i, line := range recordLines[1:] {
-		level, err := line.Level()
-		if err != nil {
-			continue
-		}
-		if level <= rootLevel {
-			break // end of record
-		}
-
-		tag, err := line.Tag()
-		if err != nil {
-			continue
-		}
+	forEachSubordinateLine(recordLines, func(tag string, subordinateLines []*Line) {
 		switch tag {
 		case "NAME":
-			g.interpretIndividualName(recordLines[1+i:], &individualInstance)
+			g.interpretIndividualName(subordinateLines, &individualInstance)
 		case "SEX":
-			g.interpretIndividualSex(recordLines[1+i:], &individualInstance)
+			g.interpretIndividualSex(subordinateLines, &individualInstance)
 		case "BIRT":
-			g.interpretIndividualEvent(recordLines[1+i:], &individualInstance, "BIRT")
+			g.interpretIndividualEvent(subordinateLines, &individualInstance, "BIRT")
 		case "DEAT":
-			g.interpretIndividualEvent(recordLines[1+i:], &individualInstance, "DEAT")
+			g.interpretIndividualEvent(subordinateLines, &individualInstance, "DEAT")
+		case "BURI":
+			g.interpretIndividualEvent(subordinateLines, &individualInstance, "BURI")
+		case "RESI":
+			g.interpretIndividualEvent(subordinateLines, &individualInstance, "RESI")
+		case "ASSO":
+			individualInstance.Associations = append(individualInstance.Associations, interpretAssociationStructure(subordinateLines))
+		case "FAMC":
+			individualInstance.ChildToFamilyLinks = append(individualInstance.ChildToFamilyLinks, interpretFamilyLinkStructure(subordinateLines))
+		case "FAMS":
+			individualInstance.SpouseToFamilyLinks = append(individualInstance.SpouseToFamilyLinks, interpretFamilyLinkStructure(subordinateLines))
+		case "ALIA":
+			individualInstance.Aliases = append(individualInstance.Aliases, subordinateLines[0].Value())
+		case "BAPL":
+			individualInstance.LdsBaptisms = append(individualInstance.LdsBaptisms, interpretLdsOrdinanceStructure(subordinateLines))
+		case "CONL":
+			individualInstance.LdsConfirmations = append(individualInstance.LdsConfirmations, interpretLdsOrdinanceStructure(subordinateLines))
+		case "ENDL":
+			individualInstance.LdsEndowments = append(individualInstance.LdsEndowments, interpretLdsOrdinanceStructure(subordinateLines))
+		case "SLGC":
+			individualInstance.LdsChildSealings = append(individualInstance.LdsChildSealings, interpretLdsOrdinanceStructure(subordinateLines))
+		case "RESN":
+			individualInstance.Restriction = subordinateLines[0].Value()
+		case "NO", "_NO":
+			individualInstance.NonEvents = append(individualInstance.NonEvents, interpretNonEventStructure(subordinateLines))
 		}
-	}
+	})
 	g.lock()
-	g.Gedcom.Individuals = append(g.Gedcom.Individuals, &individualInstance)
+	if !g.handleRecord(&individualInstance) {
+		g.Gedcom.Individuals = append(g.Gedcom.Individuals, &individualInstance)
+	}
 	g.unlock()
 
 }
@@ -157,6 +185,10 @@ func (g *ConcurrencySafeGedcom) interpretIndividualEvent(recordLines []*Line, in
 		individualInstance.BirthEvents = append(individualInstance.BirthEvents, &gedcomIndividualEvent)
 	case "DEAT":
 		individualInstance.DeathEvents = append(individualInstance.DeathEvents, &gedcomIndividualEvent)
+	case "RESI":
+		individualInstance.Residences = append(individualInstance.Residences, &gedcomIndividualEvent)
+	case "BURI":
+		individualInstance.BurialEvents = append(individualInstance.BurialEvents, &gedcomIndividualEvent)
 	}
 }
 
@@ -168,115 +200,116 @@ func (g *ConcurrencySafeGedcom) interpretIndividualName(recordLines []*Line, ind
 	}
 
 	gedcomIndividualName := name.toGedcomIndividualName()
+	gedcomIndividualName.Translations = interpretTranslations(recordLines)
 	individualInstance.Names = append(individualInstance.Names, &gedcomIndividualName)
 }
 
 func (g *ConcurrencySafeGedcom) interpretFamilyRecord(recordLines []*Line) {
 	familyId := recordLines[0].XRefID()
+	identification := interpretRecordIdentification(recordLines)
+	links := interpretStructureLinks(recordLines)
 	familyInstance := Gedcom_Family{
-		Id: familyId,
-	}
-	rootLevel, err := recordLines[0].Level()
-	if err != nil {
-		return
+		Id:                familyId,
+		UserReferences:    identification.userReferences,
+		AutomatedRecordId: identification.automatedRecordId,
+		UniqueIds:         identification.uniqueIds,
+		ChangeDate:        identification.changeDate,
+		ExternalIds:       identification.externalIds,
+		SourceCitations:   links.sourceCitations,
+		Notes:             links.notes,
+		MultimediaLinks:   links.multimediaLinks,
 	}
-	for _, line := range recordLines[1:] {
-		level, err := line.Level()
-		if err != nil {
-			continue
-		}
-		if level <= rootLevel {
-			break // end of record
-		}
-
-		tag, err := line.Tag()
-		if err != nil {
-			continue
-		}
+	forEachSubordinateLine(recordLines, func(tag string, subordinateLines []*Line) {
 		switch tag {
 		case "HUSB":
-			familyInstance.FatherId = line.Value()
+			familyInstance.FatherId = subordinateLines[0].Value()
 		case "WIFE":
-			familyInstance.MotherId = line.Value()
+			familyInstance.MotherId = subordinateLines[0].Value()
 		case "CHIL":
-			familyInstance.ChildIds = append(familyInstance.ChildIds, line.Value())
+			familyInstance.ChildIds = append(familyInstance.ChildIds, subordinateLines[0].Value())
+		case "MARR":
+			event, err := interpretEventStructure(subordinateLines)
+			if err != nil {
+				logError(subordinateLines[0], "event", err)
+				return
+			}
+			gedcomIndividualEvent := event.toGedcomIndividualEvent()
+			familyInstance.MarriageEvents = append(familyInstance.MarriageEvents, &gedcomIndividualEvent)
+		case "SLGS":
+			familyInstance.LdsSpouseSealings = append(familyInstance.LdsSpouseSealings, interpretLdsOrdinanceStructure(subordinateLines))
+		case "RESN":
+			familyInstance.Restriction = subordinateLines[0].Value()
+		case "NO", "_NO":
+			familyInstance.NonEvents = append(familyInstance.NonEvents, interpretNonEventStructure(subordinateLines))
 		}
-	}
+	})
 	g.lock()
-	g.Gedcom.Families = append(g.Gedcom.Families, &familyInstance)
+	if !g.handleRecord(&familyInstance) {
+		g.Gedcom.Families = append(g.Gedcom.Families, &familyInstance)
+	}
 	g.unlock()
 }
 
 func (g *ConcurrencySafeGedcom) interpretNoteRecord(recordLines []*Line) {
-	xRefID, submitterText := recordLines[0].XRefID(), recordLines[0].Value()
+	xRefID, submitterText := recordLines[0].XRefID(), interpretTextStructure(recordLines)
+	identification := interpretRecordIdentification(recordLines)
 	note := Gedcom_Note{
-		Id:            xRefID,
-		SubmitterText: submitterText,
+		Id:                xRefID,
+		SubmitterText:     submitterText,
+		Translations:      interpretTranslations(recordLines),
+		UserReferences:    identification.userReferences,
+		AutomatedRecordId: identification.automatedRecordId,
+		UniqueIds:         identification.uniqueIds,
+		ChangeDate:        identification.changeDate,
+		ExternalIds:       identification.externalIds,
 	}
 	g.lock()
-	g.Gedcom.Notes = append(g.Gedcom.Notes, &note)
+	if !g.handleRecord(&note) {
+		g.Gedcom.Notes = append(g.Gedcom.Notes, &note)
+	}
 	g.unlock()
 }
 
 func (g *ConcurrencySafeGedcom) interpretMultimediaRecord(recordLines []*Line) {
 	xRefID := recordLines[0].XRefID()
+	identification := interpretRecordIdentification(recordLines)
+	links := interpretStructureLinks(recordLines)
 	multimedia := Gedcom_Multimedia{
-		Id:    xRefID,
-		Files: []*Gedcom_Multimedia_File{},
+		Id:                xRefID,
+		Files:             []*Gedcom_Multimedia_File{},
+		UserReferences:    identification.userReferences,
+		AutomatedRecordId: identification.automatedRecordId,
+		UniqueIds:         identification.uniqueIds,
+		ChangeDate:        identification.changeDate,
+		ExternalIds:       identification.externalIds,
+		Notes:             links.notes,
+		SourceCitations:   links.sourceCitations,
 	}
-	rootLevel, err := recordLines[0].Level()
-	if err != nil {
-		return
-	}
-	for i, line := range recordLines[1:] {
-		level, err := line.Level()
-		if err != nil {
-			continue
-		}
-		if level <= rootLevel {
-			break // end of record
-		}
-
-		tag, err := line.Tag()
-		if err != nil {
-			continue
-		}
+	forEachSubordinateLine(recordLines, func(tag string, subordinateLines []*Line) {
 		switch tag {
 		case "FILE":
-			// TODO: extract to function
-			reference := line.Value()
-			file := Gedcom_Multimedia_File{
-				Reference: reference,
-			}
-			for _, fileLine := range recordLines[i+1:] {
-				fileLevel, err := fileLine.Level()
-				if err != nil {
-					continue
-				}
-				if fileLevel <= rootLevel+1 {
-					break // end of record
-				}
-				fileTag, err := fileLine.Tag()
-				if err != nil {
-					continue
-				}
-				switch fileTag {
-				case "FORM":
-					file.Format = fileLine.Value()
-				}
-			}
-			multimedia.Files = append(multimedia.Files, &file)
+			multimedia.Files = append(multimedia.Files, interpretMultimediaFileStructure(subordinateLines))
 		}
-	}
+	})
 	g.lock()
-	g.Multimedias = append(g.Multimedias, &multimedia)
+	if !g.handleRecord(&multimedia) {
+		g.Multimedias = append(g.Multimedias, &multimedia)
+	}
 	g.unlock()
 }
 
 func (g *ConcurrencySafeGedcom) interpretRepositoryRecord(recordLines []*Line) {
 	xRefID := recordLines[0].XRefID()
+	identification := interpretRecordIdentification(recordLines)
 	repository := Gedcom_Repository{
-		Id: xRefID,
+		Id:                xRefID,
+		Address:           interpretAddressStructure(recordLines),
+		UserReferences:    identification.userReferences,
+		AutomatedRecordId: identification.automatedRecordId,
+		UniqueIds:         identification.uniqueIds,
+		ChangeDate:        identification.changeDate,
+		ExternalIds:       identification.externalIds,
+		Notes:             interpretStructureLinks(recordLines).notes,
 	}
 	rootLevel, err := recordLines[0].Level()
 	if err != nil {
@@ -301,53 +334,110 @@ func (g *ConcurrencySafeGedcom) interpretRepositoryRecord(recordLines []*Line) {
 		}
 	}
 	g.lock()
-	g.Gedcom.Repositories = append(g.Gedcom.Repositories, &repository)
+	if !g.handleRecord(&repository) {
+		g.Gedcom.Repositories = append(g.Gedcom.Repositories, &repository)
+	}
 	g.unlock()
 }
 
 func (g *ConcurrencySafeGedcom) interpretSourceRecord(recordLines []*Line) {
 	xRefID := recordLines[0].XRefID()
+	identification := interpretRecordIdentification(recordLines)
+	links := interpretStructureLinks(recordLines)
 	source := Gedcom_Source{
-		Id: xRefID,
+		Id:                xRefID,
+		UserReferences:    identification.userReferences,
+		AutomatedRecordId: identification.automatedRecordId,
+		UniqueIds:         identification.uniqueIds,
+		ChangeDate:        identification.changeDate,
+		ExternalIds:       identification.externalIds,
+		Notes:             links.notes,
+		MultimediaLinks:   links.multimediaLinks,
 	}
+	forEachSubordinateLine(recordLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "AUTH":
+			source.Author = interpretTextStructure(subordinateLines)
+		case "TITL":
+			source.Title = interpretTextStructure(subordinateLines)
+		case "PUBL":
+			source.Publication = interpretTextStructure(subordinateLines)
+		case "REPO":
+			source.RepositoryCitations = append(source.RepositoryCitations, interpretRepositoryCitationStructure(subordinateLines))
+		}
+	})
 	g.lock()
-	g.Gedcom.Sources = append(g.Gedcom.Sources, &source)
+	if !g.handleRecord(&source) {
+		g.Gedcom.Sources = append(g.Gedcom.Sources, &source)
+	}
 	g.unlock()
 }
 
 func (g *ConcurrencySafeGedcom) interpretSubmitterRecord(recordLines []*Line) {
 	xRefID := recordLines[0].XRefID()
+	identification := interpretRecordIdentification(recordLines)
 	submitterInstance := Gedcom_Submitter{
-		Id: xRefID,
-	}
-	rootLevel, err := recordLines[0].Level()
-	if err != nil {
-		return
+		Id:                xRefID,
+		Address:           interpretAddressStructure(recordLines),
+		UserReferences:    identification.userReferences,
+		AutomatedRecordId: identification.automatedRecordId,
+		UniqueIds:         identification.uniqueIds,
+		ChangeDate:        identification.changeDate,
+		ExternalIds:       identification.externalIds,
 	}
-	for _, line := range recordLines[1:] {
-		level, err := line.Level()
-		if err != nil {
-			continue
-		}
-		if level <= rootLevel {
-			break // end of record
-		}
-
-		tag, err := line.Tag()
-		if err != nil {
-			continue
-		}
+	forEachSubordinateLine(recordLines, func(tag string, subordinateLines []*Line) {
 		switch tag {
 		case "NAME":
-			submitterInstance.Name = line.Value()
+			submitterInstance.Name = subordinateLines[0].Value()
+		case "LANG":
+			submitterInstance.Languages = append(submitterInstance.Languages, subordinateLines[0].Value())
+		case "OBJE":
+			submitterInstance.MultimediaLinks = append(submitterInstance.MultimediaLinks, interpretMultimediaLinkStructure(subordinateLines))
+		case "RFN":
+			submitterInstance.RegisteredReferenceNumber = subordinateLines[0].Value()
 		}
-	}
+	})
 	g.lock()
-	g.Gedcom.Submitters = append(g.Gedcom.Submitters, &submitterInstance)
+	if !g.handleRecord(&submitterInstance) {
+		g.Gedcom.Submitters = append(g.Gedcom.Submitters, &submitterInstance)
+	}
 	g.unlock()
 
 }
 
+func (g *ConcurrencySafeGedcom) interpretSubmissionRecord(recordLines []*Line) {
+	xRefID := recordLines[0].XRefID()
+	submission := Gedcom_SubmissionType{
+		Id: xRefID,
+	}
+	forEachSubordinateLine(recordLines, func(tag string, subordinateLines []*Line) {
+		value := subordinateLines[0].Value()
+		switch tag {
+		case "SUBM":
+			submission.SubmitterId = value
+		case "FAMF":
+			submission.FamilyFileName = value
+		case "TEMP":
+			submission.TempleCode = value
+		case "ANCE":
+			submission.GenerationsOfAncestors = value
+		case "DESC":
+			submission.GenerationsOfDescendants = value
+		case "ORDI":
+			submission.OrdinanceProcessFlag = value
+		case "RIN":
+			submission.AutomatedRecordId = value
+		case "CHAN":
+			submission.ChangeDate = interpretChangeDateStructure(subordinateLines)
+		}
+	})
+	g.lock()
+	if !g.handleRecord(&submission) {
+		g.Gedcom.Submission = &submission
+	}
+	g.unlock()
+}
+
 func logError(firstLine *Line, structureKind string, err error) {
 	l := firstLine.lineString
 	log.Printf("failed to interpret %s structure starting with %s with error: %s\n", structureKind, *l, err)
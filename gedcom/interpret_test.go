@@ -0,0 +1,132 @@
+package gedcom
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// interpretGedcomLines interprets gedcom lines the way a parsed file would be interpreted
+func interpretGedcomLines(gedcomLines []string) *ConcurrencySafeGedcom {
+	g := NewConcurrencySafeGedcom()
+	waitGroup := &sync.WaitGroup{}
+	headerInterpreted := false
+	var record []*Line
+	interpret := func() {
+		if len(record) == 0 {
+			return
+		}
+		if !headerInterpreted {
+			_ = g.InterpretHeader(record)
+			headerInterpreted = true
+		} else {
+			waitGroup.Add(1)
+			g.InterpretRecord(record, waitGroup)
+		}
+	}
+	for _, gedcomLine := range gedcomLines {
+		line := NewLine(gedcomLine)
+		if level, err := line.Level(); err == nil && level == 0 {
+			interpret()
+			record = nil
+		}
+		record = append(record, line)
+	}
+	interpret()
+	waitGroup.Wait()
+	return g
+}
+
+var submissionLines = []string{
+	"0 HEAD",
+	"1 SUBM @U1@",
+	"1 SUBN @SUBN1@",
+	"0 @U1@ SUBM",
+	"1 NAME John Doe",
+	"1 ADDR 1 Main Street",
+	"1 EMAIL john@example.org",
+	"1 OBJE @M1@",
+	"1 OBJE",
+	"2 FILE portrait.jpg",
+	"3 FORM jpeg",
+	"2 TITL Portrait of John",
+	"1 LANG English",
+	"1 LANG Dutch",
+	"1 RFN 12345",
+	"1 RIN 1",
+	"1 CHAN",
+	"2 DATE 3 MAR 2021",
+	"3 TIME 10:15:00",
+	"0 @SUBN1@ SUBN",
+	"1 SUBM @U1@",
+	"1 FAMF Doe family",
+	"1 TEMP SLAKE",
+	"1 ANCE 4",
+	"1 DESC 2",
+	"1 ORDI yes",
+	"1 RIN 2",
+	"1 CHAN",
+	"2 DATE 4 MAR 2021",
+	"0 TRLR",
+}
+
+var expectedSubmitter = &Gedcom_Submitter{
+	Id:   "@U1@",
+	Name: "John Doe",
+	Address: &Gedcom_Address{
+		Text:           "1 Main Street",
+		EmailAddresses: []string{"john@example.org"},
+	},
+	Languages: []string{"English", "Dutch"},
+	MultimediaLinks: []*Gedcom_MultimediaLink{
+		{MultimediaId: "@M1@"},
+		{
+			Files: []*Gedcom_Multimedia_File{{Reference: "portrait.jpg", Format: "jpeg"}},
+			Title: "Portrait of John",
+		},
+	},
+	RegisteredReferenceNumber: "12345",
+	AutomatedRecordId:         "1",
+	ChangeDate: &Gedcom_ChangeDate{
+		Date: &Gedcom_Individual_Date{Year: "2021", Month: "03", Day: "3"},
+		Time: "10:15:00",
+	},
+}
+
+var expectedSubmission = &Gedcom_SubmissionType{
+	Id:                       "@SUBN1@",
+	SubmitterId:              "@U1@",
+	FamilyFileName:           "Doe family",
+	TempleCode:               "SLAKE",
+	GenerationsOfAncestors:   "4",
+	GenerationsOfDescendants: "2",
+	OrdinanceProcessFlag:     "yes",
+	AutomatedRecordId:        "2",
+	ChangeDate: &Gedcom_ChangeDate{
+		Date: &Gedcom_Individual_Date{Year: "2021", Month: "03", Day: "4"},
+	},
+}
+
+func TestInterpretSubmissionAndSubmitterRecords(t *testing.T) {
+	g := interpretGedcomLines(submissionLines)
+	assertSubmissionAndSubmitter(t, g)
+
+	buf, err := g.ToSerializedGedcom(nil)
+	if err != nil {
+		t.Fatalf("failed to serialize gedcom with error: %s", err)
+	}
+	assertSubmissionAndSubmitter(t, interpretGedcomLines(splitLines(buf.String())))
+}
+
+func assertSubmissionAndSubmitter(t *testing.T, g *ConcurrencySafeGedcom) {
+	if len(g.Submitters) != 1 || !proto.Equal(g.Submitters[0], expectedSubmitter) {
+		t.Errorf("result submitters do not equal expected; result: %+v, expected %+v", g.Submitters, expectedSubmitter)
+	}
+	if !proto.Equal(g.Submission, expectedSubmission) {
+		t.Errorf("result submission does not equal expected; result: %+v, expected %+v", g.Submission, expectedSubmission)
+	}
+	if g.Header.Submission != expectedSubmission.Id {
+		t.Errorf("result header submission %s does not equal expected %s", g.Header.Submission, expectedSubmission.Id)
+	}
+}
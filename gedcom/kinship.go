@@ -0,0 +1,50 @@
+package gedcom
+
+// parentIdsByIndividualIds indexes the ids of the parents of every individual that is a child in a family
+func parentIdsByIndividualIds(families []*Gedcom_Family) map[string][]string {
+	result := map[string][]string{}
+	for _, f := range families {
+		for _, childId := range f.ChildIds {
+			for _, parentId := range []string{f.FatherId, f.MotherId} {
+				if parentId != "" {
+					result[childId] = append(result[childId], parentId)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// childIdsByIndividualIds indexes the ids of the children of every individual that is a spouse in a family
+func childIdsByIndividualIds(families []*Gedcom_Family) map[string][]string {
+	result := map[string][]string{}
+	for _, f := range families {
+		for _, parentId := range []string{f.FatherId, f.MotherId} {
+			if parentId != "" {
+				result[parentId] = append(result[parentId], f.ChildIds...)
+			}
+		}
+	}
+	return result
+}
+
+// forEachRelative calls fn for every relative found by following the given kinship index up to maxGenerations,
+// along with the amount of generations between the individual and the relative
+func forEachRelative(individualId string, relativeIdsByIndividualIds map[string][]string, maxGenerations int, fn func(relativeId string, generations int)) {
+	visited := map[string]bool{individualId: true}
+	generation := []string{individualId}
+	for generations := 1; generations <= maxGenerations && len(generation) > 0; generations++ {
+		nextGeneration := []string{}
+		for _, id := range generation {
+			for _, relativeId := range relativeIdsByIndividualIds[id] {
+				if visited[relativeId] {
+					continue
+				}
+				visited[relativeId] = true
+				fn(relativeId, generations)
+				nextGeneration = append(nextGeneration, relativeId)
+			}
+		}
+		generation = nextGeneration
+	}
+}
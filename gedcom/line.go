@@ -171,3 +171,45 @@ func (gf *GedcomFields) ToLine() (string, error) {
 
 	return sb.String(), nil
 }
+
+// forEachSubordinateLine calls fn for every line that is directly subordinate to the first of the given lines,
+// i.e. every line with a level exactly one deeper, until the end of the structure is reached.
+// fn receives the tag of the subordinate line and the lines starting at that subordinate line.
+func forEachSubordinateLine(lines []*Line, fn func(tag string, subordinateLines []*Line)) {
+	rootLevel, err := lines[0].Level()
+	if err != nil {
+		return
+	}
+	for i, line := range lines[1:] {
+		level, err := line.Level()
+		if err != nil {
+			continue
+		}
+		if level <= rootLevel {
+			break // end of structure
+		}
+		if level != rootLevel+1 {
+			continue // deeper lines are handled by the subordinate structure
+		}
+
+		tag, err := line.Tag()
+		if err != nil {
+			continue
+		}
+		fn(tag, lines[1+i:])
+	}
+}
+
+// voidPointer is the GEDCOM 7.0 pointer to nothing, used where a structure requires a pointer to a record that isn't known
+const voidPointer = "@VOID@"
+
+// isXRefID reports whether a value is a cross-reference identifier pointing to a record, e.g. @I1@.
+// The void pointer doesn't point to a record.
+func isXRefID(value string) bool {
+	return len(value) > 2 && value[0] == '@' && value[len(value)-1] == '@' && value != voidPointer
+}
+
+// isPointer reports whether a value is a pointer, either to a record or the void pointer
+func isPointer(value string) bool {
+	return isXRefID(value) || value == voidPointer
+}
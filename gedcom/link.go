@@ -0,0 +1,57 @@
+package gedcom
+
+import (
+	"bytes"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// forEachStructure calls fn for a message and every structure in it, depth first,
+// not descending into the structures for which fn returns false
+func forEachStructure(message protoreflect.Message, fn func(structure protoreflect.Message) bool) {
+	if !fn(message) {
+		return
+	}
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		if field.IsList() {
+			for n := 0; n < value.List().Len(); n++ {
+				forEachStructure(value.List().Get(n).Message(), fn)
+			}
+		} else {
+			forEachStructure(value.Message(), fn)
+		}
+		return true
+	})
+}
+
+// structureLinks holds the source citations, notes and multimedia links of a record or event
+type structureLinks struct {
+	sourceCitations []*Gedcom_SourceCitation
+	notes           []*Gedcom_NoteLink
+	multimediaLinks []*Gedcom_MultimediaLink
+}
+
+// interpretStructureLinks interprets the SOUR, NOTE (SNOTE in GEDCOM 7.0) and OBJE lines directly subordinate to a record or event
+func interpretStructureLinks(structureLines []*Line) structureLinks {
+	links := structureLinks{}
+	forEachSubordinateLine(structureLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "SOUR":
+			links.sourceCitations = append(links.sourceCitations, interpretSourceCitationStructure(subordinateLines))
+		case "NOTE", "SNOTE":
+			links.notes = append(links.notes, interpretNoteLinkStructure(subordinateLines))
+		case "OBJE":
+			links.multimediaLinks = append(links.multimediaLinks, interpretMultimediaLinkStructure(subordinateLines))
+		}
+	})
+	return links
+}
+
+func createAndWriteStructureLinkLines(links structureLinks, level int, lineCounter *int, buf *bytes.Buffer) {
+	createAndWriteSourceCitationLines(links.sourceCitations, level, lineCounter, buf)
+	createAndWriteNoteLinkLines(links.notes, level, lineCounter, buf)
+	createAndWriteMultimediaLinkLines(links.multimediaLinks, level, lineCounter, buf)
+}
@@ -0,0 +1,139 @@
+package gedcom
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+var linkLines = []string{
+	"0 HEAD",
+	"0 @I1@ INDI",
+	"1 NAME Harry /Potter/",
+	"1 BIRT",
+	"2 DATE 31 JUL 1980",
+	"2 SOUR @S1@",
+	"3 PAGE 42",
+	"2 NOTE @N1@",
+	"1 SOUR @S1@",
+	"1 NOTE Raised by his aunt",
+	"1 OBJE @M1@",
+	"1 FAMS @F1@",
+	"0 @F1@ FAM",
+	"1 HUSB @I1@",
+	"1 MARR",
+	"2 OBJE",
+	"3 FILE wedding.jpg",
+	"4 FORM jpg",
+	"1 SOUR @S404@",
+	"1 NOTE @N404@",
+	"0 @S1@ SOUR",
+	"0 @N1@ NOTE Born at the end of July",
+	"0 @M1@ OBJE",
+	"1 FILE scar.jpg",
+	"0 TRLR",
+}
+
+func TestStructureLinks(t *testing.T) {
+	g := interpretGedcomLines(linkLines)
+	assertStructureLinks(t, g)
+
+	buf, err := g.ToSerializedGedcom(nil)
+	if err != nil {
+		t.Fatalf("failed to serialize gedcom with error: %s", err)
+	}
+	assertStructureLinks(t, interpretGedcomLines(splitLines(buf.String())))
+}
+
+func assertStructureLinks(t *testing.T, g *ConcurrencySafeGedcom) {
+	i, f := g.IndividualsByIds()["@I1@"], g.FamiliesByIds()["@F1@"]
+	if i == nil || f == nil {
+		t.Fatalf("expected individual @I1@ and family @F1@ to be interpreted")
+	}
+	expectedIndividual := &Gedcom_Individual{
+		SourceCitations: []*Gedcom_SourceCitation{{SourceId: "@S1@"}},
+		Notes:           []*Gedcom_NoteLink{{SubmitterText: "Raised by his aunt"}},
+		MultimediaLinks: []*Gedcom_MultimediaLink{{MultimediaId: "@M1@"}},
+	}
+	result := &Gedcom_Individual{SourceCitations: i.SourceCitations, Notes: i.Notes, MultimediaLinks: i.MultimediaLinks}
+	if !proto.Equal(result, expectedIndividual) {
+		t.Errorf("expected individual links %v, found %v", expectedIndividual, result)
+	}
+	expectedBirth := &Gedcom_Individual_Event{
+		Date:            &Gedcom_Individual_Date{Year: "1980", Month: "07", Day: "31"},
+		SourceCitations: []*Gedcom_SourceCitation{{SourceId: "@S1@", Page: "42"}},
+		Notes:           []*Gedcom_NoteLink{{NoteId: "@N1@"}},
+	}
+	if len(i.BirthEvents) != 1 || !proto.Equal(i.BirthEvents[0], expectedBirth) {
+		t.Errorf("expected birth %v, found %v", expectedBirth, i.BirthEvents)
+	}
+	if len(f.SourceCitations) != 1 || len(f.Notes) != 1 {
+		t.Errorf("expected a source citation and note of the family, found %v and %v", f.SourceCitations, f.Notes)
+	}
+	expectedMarriageLinks := []*Gedcom_MultimediaLink{{Files: []*Gedcom_Multimedia_File{{Reference: "wedding.jpg", Format: "jpg"}}}}
+	if len(f.MarriageEvents) != 1 || len(f.MarriageEvents[0].MultimediaLinks) != 1 || !proto.Equal(f.MarriageEvents[0].MultimediaLinks[0], expectedMarriageLinks[0]) {
+		t.Errorf("expected marriage with multimedia links %v, found %v", expectedMarriageLinks, f.MarriageEvents)
+	}
+}
+
+func TestRecordStructureLinks(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"0 @S1@ SOUR",
+		"1 TITL Daily Prophet",
+		"1 NOTE @N1@",
+		"1 OBJE @M1@",
+		"0 @R1@ REPO",
+		"1 NAME Hogwarts Library",
+		"1 NOTE Restricted section",
+		"0 @M1@ OBJE",
+		"1 FILE prophet.jpg",
+		"1 NOTE @N404@",
+		"1 SOUR @S1@",
+		"0 @N1@ NOTE Front page",
+		"0 TRLR",
+	})
+	assertRecordStructureLinks := func(g *ConcurrencySafeGedcom) {
+		expected := &Gedcom{
+			Multimedias: []*Gedcom_Multimedia{{
+				Id:              "@M1@",
+				Notes:           []*Gedcom_NoteLink{{NoteId: "@N404@"}},
+				SourceCitations: []*Gedcom_SourceCitation{{SourceId: "@S1@"}},
+			}},
+			Repositories: []*Gedcom_Repository{{Id: "@R1@", Notes: []*Gedcom_NoteLink{{SubmitterText: "Restricted section"}}}},
+			Sources: []*Gedcom_Source{{
+				Id:              "@S1@",
+				Notes:           []*Gedcom_NoteLink{{NoteId: "@N1@"}},
+				MultimediaLinks: []*Gedcom_MultimediaLink{{MultimediaId: "@M1@"}},
+			}},
+		}
+		result := &Gedcom{}
+		for _, m := range g.Multimedias {
+			result.Multimedias = append(result.Multimedias, &Gedcom_Multimedia{Id: m.Id, Notes: m.Notes, SourceCitations: m.SourceCitations})
+		}
+		for _, r := range g.Repositories {
+			result.Repositories = append(result.Repositories, &Gedcom_Repository{Id: r.Id, Notes: r.Notes})
+		}
+		for _, s := range g.Sources {
+			result.Sources = append(result.Sources, &Gedcom_Source{Id: s.Id, Notes: s.Notes, MultimediaLinks: s.MultimediaLinks})
+		}
+		if !proto.Equal(result, expected) {
+			t.Errorf("expected record links %v, found %v", expected, result)
+		}
+	}
+	assertRecordStructureLinks(g)
+
+	buf, err := g.ToSerializedGedcom(nil)
+	if err != nil {
+		t.Fatalf("failed to serialize gedcom with error: %s", err)
+	}
+	assertRecordStructureLinks(interpretGedcomLines(splitLines(buf.String())))
+
+	diagnostics := g.ValidatePointerIntegrity(DanglingPointersRemove)
+	if len(diagnostics) != 1 || diagnostics[0].XRefId != "@N404@" {
+		t.Errorf("expected the dangling note pointer of the multimedia record to be reported, got %v", diagnostics)
+	}
+	if m := g.Multimedias[0]; len(m.Notes) != 0 || len(m.SourceCitations) != 1 {
+		t.Errorf("expected the dangling note of the multimedia record to be removed, got %v", m)
+	}
+}
@@ -0,0 +1,251 @@
+package gedcom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// names of the plausibility rules checked by Lint
+const (
+	LintRuleDeathBeforeBirth      = "death-before-birth"
+	LintRuleParentAge             = "parent-age"
+	LintRuleLifespan              = "lifespan"
+	LintRuleBirthAfterMotherDeath = "birth-after-mother-death"
+	LintRuleMarriageAge           = "marriage-age"
+	LintRuleEventAfterBurial      = "event-after-burial"
+)
+
+var LintRules = []string{
+	LintRuleDeathBeforeBirth,
+	LintRuleParentAge,
+	LintRuleLifespan,
+	LintRuleBirthAfterMotherDeath,
+	LintRuleMarriageAge,
+	LintRuleEventAfterBurial,
+}
+
+// default thresholds of the plausibility rules, in years
+const (
+	DefaultMinParentAge   = 12
+	DefaultMaxParentAge   = 70
+	DefaultMaxLifespan    = 120
+	DefaultMinMarriageAge = 12
+)
+
+// LintOptions configure which plausibility rules are checked and the thresholds they use.
+// Thresholds that aren't set fall back to their defaults.
+type LintOptions struct {
+	DisabledRules  map[string]bool
+	MinParentAge   int
+	MaxParentAge   int
+	MaxLifespan    int
+	MinMarriageAge int
+}
+
+// ParseLintRules parses a comma separated list of lint rule names
+func ParseLintRules(value string) (map[string]bool, error) {
+	rules := map[string]bool{}
+	for _, rule := range strings.Split(value, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		if !isLintRule(rule) {
+			return nil, fmt.Errorf("invalid lint rule %s, expected one of: %s", rule, strings.Join(LintRules, "|"))
+		}
+		rules[rule] = true
+	}
+	return rules, nil
+}
+
+func isLintRule(value string) bool {
+	for _, rule := range LintRules {
+		if rule == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (options *LintOptions) withDefaults() *LintOptions {
+	result := LintOptions{}
+	if options != nil {
+		result = *options
+	}
+	if result.MinParentAge <= 0 {
+		result.MinParentAge = DefaultMinParentAge
+	}
+	if result.MaxParentAge <= 0 {
+		result.MaxParentAge = DefaultMaxParentAge
+	}
+	if result.MaxLifespan <= 0 {
+		result.MaxLifespan = DefaultMaxLifespan
+	}
+	if result.MinMarriageAge <= 0 {
+		result.MinMarriageAge = DefaultMinMarriageAge
+	}
+	return &result
+}
+
+// Lint checks the gedcom for biologically or chronologically implausible data and reports it, without changing anything.
+// Dates are compared and ages are calculated as precisely as the dates are known.
+func (g *ConcurrencySafeGedcom) Lint(options *LintOptions) []*Diagnostic {
+	options = options.withDefaults()
+	enabled := func(rule string) bool {
+		return !options.DisabledRules[rule]
+	}
+	individuals := g.IndividualsByIds()
+	familiesBySpouseIds := map[string][]*Gedcom_Family{}
+	for _, f := range g.Families {
+		for _, spouseId := range []string{f.FatherId, f.MotherId} {
+			if spouseId != "" {
+				familiesBySpouseIds[spouseId] = append(familiesBySpouseIds[spouseId], f)
+			}
+		}
+	}
+
+	var diagnostics []*Diagnostic
+	for _, indi := range g.Individuals {
+		birth, hasBirth := earliestEventDate(indi.BirthEvents)
+		death, hasDeath := earliestEventDate(indi.DeathEvents)
+
+		if enabled(LintRuleDeathBeforeBirth) && hasBirth && hasDeath {
+			if comparison, ok := compareDates(death, birth); ok && comparison < 0 {
+				diagnostics = append(diagnostics, newDiagnostic(LintRuleDeathBeforeBirth, SeverityWarning, indi.Id,
+					"died (%s) before being born (%s)", toDateValue(death), toDateValue(birth)))
+			}
+		}
+		if enabled(LintRuleLifespan) {
+			if age, ok := yearsBetween(birth, death); ok && age > options.MaxLifespan {
+				diagnostics = append(diagnostics, newDiagnostic(LintRuleLifespan, SeverityWarning, indi.Id,
+					"lived %d years, more than %d", age, options.MaxLifespan))
+			}
+		}
+		if enabled(LintRuleEventAfterBurial) {
+			diagnostics = append(diagnostics, eventsAfterBurial(indi, familiesBySpouseIds[indi.Id])...)
+		}
+	}
+
+	for _, f := range g.Families {
+		father, mother := individuals[f.FatherId], individuals[f.MotherId]
+		if enabled(LintRuleParentAge) || enabled(LintRuleBirthAfterMotherDeath) {
+			for _, childId := range f.ChildIds {
+				child, ok := individuals[childId]
+				if !ok {
+					continue
+				}
+				childBirth, ok := earliestEventDate(child.BirthEvents)
+				if !ok {
+					continue
+				}
+				if enabled(LintRuleParentAge) {
+					for _, parent := range []*Gedcom_Individual{father, mother} {
+						if d := parentAgeDiagnostic(parent, child, childBirth, options); d != nil {
+							diagnostics = append(diagnostics, d)
+						}
+					}
+				}
+				if enabled(LintRuleBirthAfterMotherDeath) && mother != nil {
+					motherDeath, ok := earliestEventDate(mother.DeathEvents)
+					if !ok {
+						continue
+					}
+					if comparison, ok := compareDates(childBirth, motherDeath); ok && comparison > 0 {
+						diagnostics = append(diagnostics, newDiagnostic(LintRuleBirthAfterMotherDeath, SeverityWarning, child.Id,
+							"born (%s) after the death of mother %s (%s)", toDateValue(childBirth), mother.Id, toDateValue(motherDeath)))
+					}
+				}
+			}
+		}
+		if enabled(LintRuleMarriageAge) {
+			marriage, ok := earliestEventDate(f.MarriageEvents)
+			if !ok {
+				continue
+			}
+			for _, spouse := range []*Gedcom_Individual{father, mother} {
+				if spouse == nil {
+					continue
+				}
+				spouseBirth, _ := earliestEventDate(spouse.BirthEvents)
+				if age, ok := yearsBetween(spouseBirth, marriage); ok && age < options.MinMarriageAge {
+					diagnostics = append(diagnostics, newDiagnostic(LintRuleMarriageAge, SeverityWarning, spouse.Id,
+						"married in family %s at age %d, younger than %d", f.Id, age, options.MinMarriageAge))
+				}
+			}
+		}
+	}
+	return diagnostics
+}
+
+func parentAgeDiagnostic(parent *Gedcom_Individual, child *Gedcom_Individual, childBirth *Gedcom_Individual_Date, options *LintOptions) *Diagnostic {
+	if parent == nil {
+		return nil
+	}
+	parentBirth, _ := earliestEventDate(parent.BirthEvents)
+	age, ok := yearsBetween(parentBirth, childBirth)
+	if !ok {
+		return nil
+	}
+	if age < options.MinParentAge {
+		return newDiagnostic(LintRuleParentAge, SeverityWarning, parent.Id,
+			"was %d years old at the birth of child %s, younger than %d", age, child.Id, options.MinParentAge)
+	}
+	if age > options.MaxParentAge {
+		return newDiagnostic(LintRuleParentAge, SeverityWarning, parent.Id,
+			"was %d years old at the birth of child %s, older than %d", age, child.Id, options.MaxParentAge)
+	}
+	return nil
+}
+
+// eventsAfterBurial reports the events of an individual, and of the families they're a spouse in,
+// that took place after their earliest burial
+func eventsAfterBurial(indi *Gedcom_Individual, families []*Gedcom_Family) []*Diagnostic {
+	burial, ok := earliestEventDate(indi.BurialEvents)
+	if !ok {
+		return nil
+	}
+	var diagnostics []*Diagnostic
+	check := func(tag string, events []*Gedcom_Individual_Event) {
+		for _, event := range events {
+			if comparison, ok := compareDates(event.Date, burial); ok && comparison > 0 {
+				diagnostics = append(diagnostics, newDiagnostic(LintRuleEventAfterBurial, SeverityWarning, indi.Id,
+					"%s event (%s) after burial (%s)", tag, toDateValue(event.Date), toDateValue(burial)))
+			}
+		}
+	}
+	for _, eventType := range IndividualEventTypes {
+		// later burials are reburials rather than implausible
+		if eventType.Tag != "BURI" {
+			check(eventType.Tag, *eventType.Events(indi))
+		}
+	}
+	for _, f := range families {
+		check(MarriageEventTag, f.MarriageEvents)
+	}
+	return diagnostics
+}
+
+// yearsBetween returns the age in whole years from one date to another, which fails if the year of either is unknown.
+// The age is one year less if the anniversary of the first date comes later in the year of the second date,
+// as far as the months and days of both dates are known.
+func yearsBetween(from *Gedcom_Individual_Date, to *Gedcom_Individual_Date) (int, bool) {
+	fromYear, ok := dateYear(from)
+	if !ok {
+		return 0, false
+	}
+	toYear, ok := dateYear(to)
+	if !ok {
+		return 0, false
+	}
+	years := toYear - fromYear
+	anniversary := &Gedcom_Individual_Date{
+		Year:  strconv.Itoa(toYear),
+		Month: from.GetMonth(),
+		Day:   from.GetDay(),
+	}
+	if comparison, _ := compareDates(to, anniversary); comparison < 0 {
+		years--
+	}
+	return years, true
+}
@@ -0,0 +1,128 @@
+package gedcom
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+var implausibleGedcomLines = []string{
+	"0 HEAD",
+	"1 GEDC",
+	"2 VERS 5.5.1",
+	"0 @I1@ INDI",
+	"1 NAME Old /Father/",
+	"1 BIRT",
+	"2 DATE 1800",
+	"1 DEAT",
+	"2 DATE 1930",
+	"0 @I2@ INDI",
+	"1 NAME Young /Mother/",
+	"1 BIRT",
+	"2 DATE 12 MAR 1890",
+	"1 DEAT",
+	"2 DATE 5 MAR 1890",
+	"1 BURI",
+	"2 DATE 1895",
+	"1 RESI",
+	"2 DATE 1896",
+	"0 @I3@ INDI",
+	"1 NAME Late /Child/",
+	"1 BIRT",
+	"2 DATE 1899",
+	"0 @F1@ FAM",
+	"1 HUSB @I1@",
+	"1 WIFE @I2@",
+	"1 CHIL @I3@",
+	"1 MARR",
+	"2 DATE 1897",
+	"0 TRLR",
+}
+
+func TestLint(t *testing.T) {
+	cases := []struct {
+		options  *LintOptions
+		expected map[string]int
+	}{
+		{nil, map[string]int{
+			LintRuleDeathBeforeBirth:      1,
+			LintRuleLifespan:              1,
+			LintRuleEventAfterBurial:      2,
+			LintRuleParentAge:             2,
+			LintRuleBirthAfterMotherDeath: 1,
+			LintRuleMarriageAge:           1,
+		}},
+		{&LintOptions{
+			DisabledRules: map[string]bool{LintRuleEventAfterBurial: true, LintRuleBirthAfterMotherDeath: true},
+			MaxParentAge:  100,
+			MaxLifespan:   130,
+		}, map[string]int{
+			LintRuleDeathBeforeBirth: 1,
+			LintRuleParentAge:        1,
+			LintRuleMarriageAge:      1,
+		}},
+	}
+
+	for _, c := range cases {
+		g := interpretGedcomLines(implausibleGedcomLines)
+		counts := map[string]int{}
+		for _, d := range g.Lint(c.options) {
+			counts[d.Rule]++
+		}
+		if len(counts) != len(c.expected) {
+			t.Errorf("expected diagnostics %v, got %v", c.expected, counts)
+			continue
+		}
+		for rule, count := range c.expected {
+			if counts[rule] != count {
+				t.Errorf("expected %d %s diagnostics, got %d", count, rule, counts[rule])
+			}
+		}
+	}
+}
+
+func TestLintAgesAndFamilyEvents(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"0 @I1@ INDI",
+		"1 BIRT",
+		"2 DATE 12 MAR 1890",
+		"1 BURI",
+		"2 DATE 10 JUN 1950",
+		"0 @I2@ INDI",
+		"1 BIRT",
+		"2 DATE 1 MAR 1890",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"1 WIFE @I2@",
+		"1 MARR",
+		"2 DATE 1 MAR 1902",
+		"0 @F2@ FAM",
+		"1 HUSB @I1@",
+		"1 MARR",
+		"2 DATE 20 JUN 1950",
+		"0 TRLR",
+	})
+	var messages []string
+	for _, d := range g.Lint(nil) {
+		messages = append(messages, d.XRefId+" "+d.Message)
+	}
+	expected := []string{
+		"@I1@ MARR event (20 JUN 1950) after burial (10 JUN 1950)",
+		"@I1@ married in family @F1@ at age 11, younger than 12",
+	}
+	sort.Strings(messages)
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected diagnostics %v, got %v", expected, messages)
+	}
+}
+
+func TestParseLintRules(t *testing.T) {
+	rules, err := ParseLintRules("lifespan, parent-age")
+	if err != nil || len(rules) != 2 || !rules[LintRuleLifespan] || !rules[LintRuleParentAge] {
+		t.Errorf("expected lifespan and parent-age rules, got %v (%v)", rules, err)
+	}
+	if _, err := ParseLintRules("lifespan,unknown"); err == nil {
+		t.Errorf("expected error for unknown rule")
+	}
+}
@@ -0,0 +1,173 @@
+package gedcom
+
+import (
+	"fmt"
+	"time"
+)
+
+// LivingPolicy determines what happens on export to individuals who might still be alive
+type LivingPolicy string
+
+const (
+	// LivingKeep exports individuals who might still be alive as is
+	LivingKeep LivingPolicy = ""
+	// LivingRedact replaces the names and events of individuals who might still be alive with placeholders
+	LivingRedact LivingPolicy = "redact"
+	// LivingRemove removes individuals who might still be alive along with all references to them
+	LivingRemove LivingPolicy = "remove"
+)
+
+// DefaultLivingMaxAge is the age in years from which individuals without a death event are presumed dead
+const DefaultLivingMaxAge = 100
+
+// placeholder given name of individuals who might still be alive
+const livingPlaceholderName = "Living"
+
+const (
+	// amount of generations of ancestors and descendants taken into account to infer whether an individual might be alive
+	livingMaxGenerations = 3
+	minParentAge         = DefaultMinParentAge
+	maxParentAge         = DefaultMaxParentAge
+)
+
+// ParseLivingPolicy parses a living policy, where an empty value or keep exports living individuals as is
+func ParseLivingPolicy(value string) (LivingPolicy, error) {
+	switch LivingPolicy(value) {
+	case LivingKeep, "keep":
+		return LivingKeep, nil
+	case LivingRedact:
+		return LivingRedact, nil
+	case LivingRemove:
+		return LivingRemove, nil
+	}
+	return LivingKeep, fmt.Errorf("invalid living policy %s, expected one of: keep|redact|remove", value)
+}
+
+// livingIndividualIds infers which individuals might still be alive in the given year.
+// An individual is presumed dead if they have a death or burial event or were born at least maxAge years ago.
+// Without a birth date, the birth year is estimated from the dates of ancestors and descendants.
+// Individuals without any dates to go by are presumed alive.
+func livingIndividualIds(gedcom *Gedcom, maxAge int, currentYear int) map[string]bool {
+	individuals := map[string]*Gedcom_Individual{}
+	for _, indi := range gedcom.Individuals {
+		individuals[indi.Id] = indi
+	}
+	parentIds := parentIdsByIndividualIds(gedcom.Families)
+	childIds := childIdsByIndividualIds(gedcom.Families)
+
+	result := map[string]bool{}
+	for _, indi := range gedcom.Individuals {
+		if len(indi.DeathEvents) > 0 || len(indi.BurialEvents) > 0 {
+			continue
+		}
+		latestBirthYear, known := latestPossibleBirthYear(indi, individuals, parentIds, childIds)
+		if known && currentYear-latestBirthYear >= maxAge {
+			continue
+		}
+		result[indi.Id] = true
+	}
+	return result
+}
+
+// latestPossibleBirthYear determines the latest year an individual could have been born in,
+// based on their own birth date or the dates of their ancestors and descendants
+func latestPossibleBirthYear(indi *Gedcom_Individual, individuals map[string]*Gedcom_Individual, parentIds map[string][]string, childIds map[string][]string) (int, bool) {
+	if birthYear, ok := earliestEventYear(indi.BirthEvents); ok {
+		return birthYear, true
+	}
+
+	latest, known := 0, false
+	bound := func(year int) {
+		if !known || year < latest {
+			latest, known = year, true
+		}
+	}
+	forEachRelative(indi.Id, childIds, livingMaxGenerations, func(descendantId string, generations int) {
+		descendant, ok := individuals[descendantId]
+		if !ok {
+			return
+		}
+		// descendants are born, and die, after their ancestors were old enough to be parents
+		if year, ok := earliestEventYear(descendant.BirthEvents); ok {
+			bound(year - generations*minParentAge)
+		} else if year, ok := earliestEventYear(descendant.DeathEvents); ok {
+			bound(year - generations*minParentAge)
+		}
+	})
+	forEachRelative(indi.Id, parentIds, livingMaxGenerations, func(ancestorId string, generations int) {
+		ancestor, ok := individuals[ancestorId]
+		if !ok {
+			return
+		}
+		// ancestors had their children before they were too old to be parents
+		if year, ok := earliestEventYear(ancestor.BirthEvents); ok {
+			bound(year + generations*maxParentAge)
+		}
+	})
+	return latest, known
+}
+
+// applyLivingPolicy redacts or removes all individuals who might still be alive according to the given policy
+func applyLivingPolicy(gedcom *Gedcom, policy LivingPolicy, maxAge int) {
+	if policy == LivingKeep {
+		return
+	}
+	if maxAge <= 0 {
+		maxAge = DefaultLivingMaxAge
+	}
+	livingIds := livingIndividualIds(gedcom, maxAge, time.Now().Year())
+	for _, f := range gedcom.Families {
+		if livingIds[f.FatherId] || livingIds[f.MotherId] {
+			f.MarriageEvents = redactedLivingEvents(f.MarriageEvents)
+		}
+	}
+
+	switch policy {
+	case LivingRedact:
+		for i, indi := range gedcom.Individuals {
+			if livingIds[indi.Id] {
+				gedcom.Individuals[i] = redactedLivingIndividual(indi)
+			}
+		}
+	case LivingRemove:
+		removeIndividuals(gedcom, livingIds)
+	}
+}
+
+// redactedLivingIndividual keeps an individual's place in the tree,
+// while replacing their given names and the details of their events with placeholders and leaving out everything else
+func redactedLivingIndividual(indi *Gedcom_Individual) *Gedcom_Individual {
+	name := &Gedcom_Individual_Name{
+		GivenName: livingPlaceholderName,
+		Primary:   true,
+	}
+	if len(indi.Names) > 0 {
+		name.Surname = indi.Names[0].Surname
+	}
+	for _, n := range indi.Names {
+		if n.Primary {
+			name.Surname = n.Surname
+			break
+		}
+	}
+	return &Gedcom_Individual{
+		Id:                  indi.Id,
+		Names:               []*Gedcom_Individual_Name{name},
+		Gender:              indi.Gender,
+		BirthEvents:         redactedLivingEvents(indi.BirthEvents),
+		Residences:          redactedLivingEvents(indi.Residences),
+		Restriction:         indi.Restriction,
+		ChildToFamilyLinks:  familyLinkPointers(indi.ChildToFamilyLinks),
+		SpouseToFamilyLinks: familyLinkPointers(indi.SpouseToFamilyLinks),
+	}
+}
+
+func redactedLivingEvents(events []*Gedcom_Individual_Event) []*Gedcom_Individual_Event {
+	var result []*Gedcom_Individual_Event
+	for _, event := range events {
+		result = append(result, &Gedcom_Individual_Event{
+			Primary: event.Primary,
+		})
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package gedcom
+
+import (
+	"testing"
+)
+
+func individualBornIn(id string, year string) *Gedcom_Individual {
+	return &Gedcom_Individual{
+		Id:          id,
+		Names:       []*Gedcom_Individual_Name{{GivenName: id, Surname: "Potter"}},
+		BirthEvents: []*Gedcom_Individual_Event{{Date: &Gedcom_Individual_Date{Year: year}, Place: "Godric's Hollow"}},
+	}
+}
+
+func livingTestGedcom() *Gedcom {
+	return &Gedcom{
+		Individuals: []*Gedcom_Individual{
+			individualBornIn("@I1@", "1960"),
+			individualBornIn("@I2@", "1800"),
+			{Id: "@I3@", DeathEvents: []*Gedcom_Individual_Event{{}}},
+			{Id: "@I4@"},
+			{Id: "@I5@"},
+			individualBornIn("@I6@", "ABT 1890"),
+			{Id: "@I7@"},
+			individualBornIn("@I8@", "BET 1880 AND 1960"),
+		},
+		Families: []*Gedcom_Family{
+			// @I4@ is a child of @I2@, so must have been born before 1800 + 70
+			{Id: "@F1@", FatherId: "@I2@", ChildIds: []string{"@I4@"}},
+			// @I5@ is a grandparent of @I6@, so must have been born before 1890 - 2 * 12
+			{Id: "@F2@", FatherId: "@I5@", ChildIds: []string{"@I7@"}},
+			{Id: "@F3@", MotherId: "@I7@", ChildIds: []string{"@I6@", "@I1@"}},
+		},
+	}
+}
+
+func TestLivingIndividualIds(t *testing.T) {
+	livingIds := livingIndividualIds(livingTestGedcom(), DefaultLivingMaxAge, 2021)
+	expectedLiving := map[string]bool{
+		"@I1@": true,  // born less than 100 years ago
+		"@I2@": false, // born more than 100 years ago
+		"@I3@": false, // has a death event
+		"@I4@": false, // parent born in 1800
+		"@I5@": false, // grandchild born in 1890
+		"@I6@": false, // born about 1890
+		"@I7@": false, // child born in 1890
+		"@I8@": true,  // born at the latest in 1960
+	}
+	for id, expected := range expectedLiving {
+		if livingIds[id] != expected {
+			t.Errorf("expected %s to be living: %t, got %t", id, expected, livingIds[id])
+		}
+	}
+
+	livingIds = livingIndividualIds(&Gedcom{Individuals: []*Gedcom_Individual{{Id: "@I1@"}}}, DefaultLivingMaxAge, 2021)
+	if !livingIds["@I1@"] {
+		t.Errorf("expected individual without any dates to be presumed alive")
+	}
+}
+
+func TestApplyLivingPolicy(t *testing.T) {
+	redacted := livingTestGedcom()
+	applyLivingPolicy(redacted, LivingRedact, DefaultLivingMaxAge)
+	for _, indi := range redacted.Individuals {
+		if indi.Id != "@I1@" {
+			continue
+		}
+		if len(indi.Names) != 1 || indi.Names[0].GivenName != livingPlaceholderName || indi.Names[0].Surname != "Potter" {
+			t.Errorf("expected names of living individual to be replaced with placeholders, got %+v", indi.Names)
+		}
+		if len(indi.BirthEvents) != 1 || indi.BirthEvents[0].Date != nil || indi.BirthEvents[0].Place != "" {
+			t.Errorf("expected events of living individual to be redacted, got %+v", indi.BirthEvents)
+		}
+	}
+
+	removed := livingTestGedcom()
+	applyLivingPolicy(removed, LivingRemove, DefaultLivingMaxAge)
+	for _, indi := range removed.Individuals {
+		if indi.Id == "@I1@" || indi.Id == "@I8@" {
+			t.Errorf("expected living individual %s to be removed", indi.Id)
+		}
+	}
+	if f := removed.Families[2]; len(f.ChildIds) != 1 || f.ChildIds[0] != "@I6@" {
+		t.Errorf("expected references to removed individuals to be removed, got %+v", f)
+	}
+}
+
+func TestParseLivingPolicy(t *testing.T) {
+	for value, expected := range map[string]LivingPolicy{"": LivingKeep, "keep": LivingKeep, "redact": LivingRedact, "remove": LivingRemove} {
+		if policy, err := ParseLivingPolicy(value); err != nil || policy != expected {
+			t.Errorf("expected %s to be parsed as %s, got %s (%v)", value, expected, policy, err)
+		}
+	}
+	if _, err := ParseLivingPolicy("hide"); err == nil {
+		t.Errorf("expected invalid living policy to fail parsing")
+	}
+}
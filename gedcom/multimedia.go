@@ -0,0 +1,118 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+	"strconv"
+)
+
+func interpretMultimediaFileStructure(fileLines []*Line) *Gedcom_Multimedia_File {
+	file := &Gedcom_Multimedia_File{
+		Reference: fileLines[0].Value(),
+	}
+	forEachSubordinateLine(fileLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "FORM":
+			file.Format = subordinateLines[0].Value()
+		}
+	})
+	return file
+}
+
+// interpretMultimediaLinkStructure interprets a MULTIMEDIA_LINK,
+// which is either a pointer to a multimedia record or an embedded multimedia structure
+func interpretMultimediaLinkStructure(linkLines []*Line) *Gedcom_MultimediaLink {
+	link := &Gedcom_MultimediaLink{
+		MultimediaId: linkLines[0].Value(),
+	}
+	forEachSubordinateLine(linkLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "FILE":
+			link.Files = append(link.Files, interpretMultimediaFileStructure(subordinateLines))
+		case "TITL":
+			link.Title = subordinateLines[0].Value()
+		case "CROP", "_CROP":
+			link.Crop = interpretCropStructure(subordinateLines)
+		}
+	})
+	return link
+}
+
+// interpretCropStructure interprets the GEDCOM 7.0 CROP of a multimedia link,
+// the region of an image to display, in pixels
+func interpretCropStructure(cropLines []*Line) *Gedcom_MultimediaLink_CropType {
+	crop := &Gedcom_MultimediaLink_CropType{}
+	forEachSubordinateLine(cropLines, func(tag string, subordinateLines []*Line) {
+		value, err := strconv.Atoi(subordinateLines[0].Value())
+		if err != nil {
+			logError(subordinateLines[0], "crop", err)
+			return
+		}
+		switch tag {
+		case "TOP":
+			crop.Top = int32(value)
+		case "LEFT":
+			crop.Left = int32(value)
+		case "HEIGHT":
+			crop.Height = int32(value)
+		case "WIDTH":
+			crop.Width = int32(value)
+		}
+	})
+	return crop
+}
+
+func createAndWriteMultimediaFileLines(file *Gedcom_Multimedia_File, fileLevel int, lineCounter *int, buf *bytes.Buffer) {
+	err := createAndWriteLine(fileLevel, "", "FILE", file.Reference, lineCounter, buf)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if file.Format != "" {
+		formatLevel := fileLevel + 1
+		err := createAndWriteLine(formatLevel, "", "FORM", file.Format, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func createAndWriteMultimediaLinkLines(links []*Gedcom_MultimediaLink, linkLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, link := range links {
+		err := createAndWriteLine(linkLevel, "", "OBJE", link.MultimediaId, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, file := range link.Files {
+			createAndWriteMultimediaFileLines(file, linkLevel+1, lineCounter, buf)
+		}
+		if link.Title != "" {
+			err := createAndWriteLine(linkLevel+1, "", "TITL", link.Title, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+		if crop := link.Crop; crop != nil {
+			err := createAndWriteLine(linkLevel+1, "", "_CROP", "", lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			createAndWriteTagValueLines(linkLevel+2, []tagValue{
+				{"TOP", cropValue(crop.Top)},
+				{"LEFT", cropValue(crop.Left)},
+				{"HEIGHT", cropValue(crop.Height)},
+				{"WIDTH", cropValue(crop.Width)},
+			}, lineCounter, buf)
+		}
+	}
+}
+
+// cropValue formats a crop dimension as a line value, leaving out dimensions that aren't set
+func cropValue(value int32) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(value))
+}
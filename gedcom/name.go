@@ -33,6 +33,9 @@ func interpretNameStructure(nameLines []*Line) (*Name, error) {
 		if level <= rootLevel {
 			break // end  of name structure
 		}
+		if level != rootLevel+1 {
+			continue // name pieces of translations don't belong to the name itself
+		}
 
 		tag, err := nameLine.Tag()
 		if err != nil {
@@ -0,0 +1,192 @@
+package gedcom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"io"
+)
+
+/*
+NDJSON (newline delimited JSON) holds a record of a gedcom per line, as the JSON representation of the record with a
+type discriminating the kind of record, e.g. {"type":"individual","Id":"@I1@",...}. The header comes first, followed by
+the other records in no particular order, so NDJSON files can be loaded into tools like Spark or BigQuery line by line.
+*/
+
+// types of the records of NDJSON
+const (
+	HeaderRecordType     = "header"
+	IndividualRecordType = "individual"
+	FamilyRecordType     = "family"
+	MultimediaRecordType = "multimedia"
+	NoteRecordType       = "note"
+	RepositoryRecordType = "repository"
+	SourceRecordType     = "source"
+	SubmitterRecordType  = "submitter"
+	SubmissionRecordType = "submission"
+)
+
+// ndjsonRecordType holds the type of a record of NDJSON
+type ndjsonRecordType struct {
+	Type string `json:"type"`
+}
+
+// NDJSONWriter writes records as lines of NDJSON, so records can be written as they're interpreted
+type NDJSONWriter struct {
+	writer *bufio.Writer
+	// the first error writing a record ran into, records aren't written after it
+	err error
+}
+
+func NewNDJSONWriter(writer io.Writer) *NDJSONWriter {
+	return &NDJSONWriter{writer: bufio.NewWriter(writer)}
+}
+
+// WriteRecord writes a record of a type, e.g. IndividualRecordType, as a line of NDJSON
+func (w *NDJSONWriter) WriteRecord(recordType string, record interface{}) error {
+	if w.err != nil {
+		return w.err
+	}
+	recordJson, err := json.Marshal(record)
+	if err != nil {
+		w.err = err
+		return err
+	}
+	line := []byte(fmt.Sprintf(`{"type":%q`, recordType))
+	if fields := bytes.TrimPrefix(recordJson, []byte("{")); !bytes.Equal(fields, []byte("}")) {
+		line = append(line, ',')
+		line = append(line, fields...)
+	} else {
+		line = append(line, '}')
+	}
+	_, w.err = w.writer.Write(append(line, '\n'))
+	return w.err
+}
+
+// Flush writes the buffered records, returning the first error writing the records ran into
+func (w *NDJSONWriter) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.writer.Flush()
+}
+
+// WriteNDJSON writes a gedcom as NDJSON, encoding and writing one record at a time
+func (g *ConcurrencySafeGedcom) WriteNDJSON(writer io.Writer, options *ExportOptions) error {
+	ndjsonWriter := NewNDJSONWriter(writer)
+	forEachRecord(g.exportedGedcom(options), func(recordType string, record proto.Message) {
+		_ = ndjsonWriter.WriteRecord(recordType, record)
+	})
+	return ndjsonWriter.Flush()
+}
+
+// forEachRecord calls fn for every record of a gedcom along with its NDJSON record type, starting with the header
+func forEachRecord(gedcom *Gedcom, fn RecordHandler) {
+	if gedcom.Header != nil {
+		fn(HeaderRecordType, gedcom.Header)
+	}
+	for _, i := range gedcom.Individuals {
+		fn(IndividualRecordType, i)
+	}
+	for _, f := range gedcom.Families {
+		fn(FamilyRecordType, f)
+	}
+	for _, m := range gedcom.Multimedias {
+		fn(MultimediaRecordType, m)
+	}
+	for _, n := range gedcom.Notes {
+		fn(NoteRecordType, n)
+	}
+	for _, r := range gedcom.Repositories {
+		fn(RepositoryRecordType, r)
+	}
+	for _, s := range gedcom.Sources {
+		fn(SourceRecordType, s)
+	}
+	for _, s := range gedcom.Submitters {
+		fn(SubmitterRecordType, s)
+	}
+	if gedcom.Submission != nil {
+		fn(SubmissionRecordType, gedcom.Submission)
+	}
+}
+
+func (g *ConcurrencySafeGedcom) ToNDJSON(options *ExportOptions) (*[]byte, error) {
+	buf := bytes.NewBuffer([]byte{})
+	err := g.WriteNDJSON(buf, options)
+	if err != nil {
+		return nil, err
+	}
+	ndjson := buf.Bytes()
+	return &ndjson, nil
+}
+
+// InterpretNDJSON reads the records of NDJSON into a gedcom structure, one record at a time
+func InterpretNDJSON(reader io.Reader) (*ConcurrencySafeGedcom, error) {
+	gedcom := NewConcurrencySafeGedcom()
+	decoder := json.NewDecoder(reader)
+	for n := 1; ; n++ {
+		var recordJson json.RawMessage
+		err := decoder.Decode(&recordJson)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read record %d with error: %s", n, err)
+		}
+		recordType := ndjsonRecordType{}
+		err = json.Unmarshal(recordJson, &recordType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read type of record %d with error: %s", n, err)
+		}
+
+		var record interface{}
+		switch recordType.Type {
+		case HeaderRecordType:
+			gedcom.Header = &Gedcom_HeaderType{}
+			record = gedcom.Header
+		case IndividualRecordType:
+			individual := &Gedcom_Individual{}
+			gedcom.Individuals = append(gedcom.Individuals, individual)
+			record = individual
+		case FamilyRecordType:
+			family := &Gedcom_Family{}
+			gedcom.Families = append(gedcom.Families, family)
+			record = family
+		case MultimediaRecordType:
+			multimedia := &Gedcom_Multimedia{}
+			gedcom.Multimedias = append(gedcom.Multimedias, multimedia)
+			record = multimedia
+		case NoteRecordType:
+			note := &Gedcom_Note{}
+			gedcom.Notes = append(gedcom.Notes, note)
+			record = note
+		case RepositoryRecordType:
+			repository := &Gedcom_Repository{}
+			gedcom.Repositories = append(gedcom.Repositories, repository)
+			record = repository
+		case SourceRecordType:
+			source := &Gedcom_Source{}
+			gedcom.Sources = append(gedcom.Sources, source)
+			record = source
+		case SubmitterRecordType:
+			submitter := &Gedcom_Submitter{}
+			gedcom.Submitters = append(gedcom.Submitters, submitter)
+			record = submitter
+		case SubmissionRecordType:
+			gedcom.Submission = &Gedcom_SubmissionType{}
+			record = gedcom.Submission
+		default:
+			return nil, fmt.Errorf("invalid type %q of record %d, expected one of: %s|%s|%s|%s|%s|%s|%s|%s|%s", recordType.Type, n,
+				HeaderRecordType, IndividualRecordType, FamilyRecordType, MultimediaRecordType, NoteRecordType,
+				RepositoryRecordType, SourceRecordType, SubmitterRecordType, SubmissionRecordType)
+		}
+		err = json.Unmarshal(recordJson, record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s record %d with error: %s", recordType.Type, n, err)
+		}
+	}
+	return gedcom, nil
+}
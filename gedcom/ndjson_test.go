@@ -0,0 +1,88 @@
+package gedcom
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestNDJSONRoundTrip(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"1 GEDC",
+		"2 VERS 5.5.1",
+		"0 @I1@ INDI",
+		"1 NAME John /Smith/",
+		"1 FAMS @F1@",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"0 @N1@ NOTE A note",
+		"0 @S1@ SOUR",
+		"0 @R1@ REPO",
+		"1 NAME Archive",
+		"0 @M1@ OBJE",
+		"1 FILE photo.jpg",
+		"2 FORM jpg",
+		"0 @U1@ SUBM",
+		"1 NAME Submitter",
+		"0 TRLR",
+	})
+	g.Submission = &Gedcom_SubmissionType{Id: "@SUB1@"}
+	ndjson, err := g.ToNDJSON(nil)
+	if err != nil {
+		t.Fatalf("failed to write NDJSON with error: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(*ndjson), "\n"), "\n")
+	types := []string{}
+	for _, line := range lines {
+		recordType := ndjsonRecordType{}
+		if err := json.Unmarshal([]byte(line), &recordType); err != nil {
+			t.Fatalf("failed to read line %s with error: %s", line, err)
+		}
+		types = append(types, recordType.Type)
+	}
+	if types[0] != HeaderRecordType {
+		t.Errorf("expected the header first, found %v", types)
+	}
+	sort.Strings(types)
+	expectedTypes := []string{"family", "header", "individual", "multimedia", "note", "repository", "source", "submission", "submitter"}
+	if strings.Join(types, ",") != strings.Join(expectedTypes, ",") {
+		t.Errorf("expected record types %v, found %v", expectedTypes, types)
+	}
+	if !strings.Contains(string(*ndjson), `{"type":"source","Id":"@S1@"}`+"\n") {
+		t.Errorf("expected source on a line of its own, found %s", *ndjson)
+	}
+
+	result, err := InterpretNDJSON(bytes.NewReader(*ndjson))
+	if err != nil {
+		t.Fatalf("failed to read NDJSON with error: %s", err)
+	}
+	if !proto.Equal(&result.Gedcom, &g.Gedcom) {
+		t.Errorf("expected %v, found %v", &g.Gedcom, &result.Gedcom)
+	}
+}
+
+func TestInterpretNDJSON(t *testing.T) {
+	cases := []struct {
+		ndjson string
+		valid  bool
+	}{
+		{"", true},
+		{`{"type":"individual","Id":"@I1@"}` + "\n\n" + `{"type":"family"}`, true},
+		{`{"type":"place","Id":"@P1@"}`, false},
+		{`{"Id":"@I1@"}`, false},
+		{`{"type":"individual","Id":1}`, false},
+		{`{"type":"individual"`, false},
+	}
+	for _, c := range cases {
+		_, err := InterpretNDJSON(strings.NewReader(c.ndjson))
+		if (err == nil) != c.valid {
+			t.Errorf("InterpretNDJSON(%q) returned error %v", c.ndjson, err)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NoteLinks collects the notes anywhere in a record or structure, e.g. those of an individual itself
+// and of its events, associations and source citations
+func NoteLinks(record proto.Message) []*Gedcom_NoteLink {
+	links := []*Gedcom_NoteLink{}
+	forEachStructure(record.ProtoReflect(), func(structure protoreflect.Message) bool {
+		link, ok := structure.Interface().(*Gedcom_NoteLink)
+		if ok {
+			links = append(links, link)
+		}
+		return !ok
+	})
+	return links
+}
+
+// interpretNoteLinkStructure interprets a NOTE_STRUCTURE,
+// which is either a pointer to a note record or a note embedded as text.
+// GEDCOM 7.0 points to note records with SNOTE instead of NOTE.
+func interpretNoteLinkStructure(noteLines []*Line) *Gedcom_NoteLink {
+	if value := noteLines[0].Value(); isPointer(value) {
+		return &Gedcom_NoteLink{
+			NoteId: value,
+		}
+	}
+	return &Gedcom_NoteLink{
+		SubmitterText: interpretTextStructure(noteLines),
+		Translations:  interpretTranslations(noteLines),
+	}
+}
+
+func createAndWriteNoteLinkLines(notes []*Gedcom_NoteLink, noteLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, note := range notes {
+		var err error
+		if note.NoteId != "" {
+			err = createAndWriteLine(noteLevel, "", "NOTE", note.NoteId, lineCounter, buf)
+		} else {
+			err = createAndWriteTextLines(noteLevel, "", "NOTE", note.SubmitterText, lineCounter, buf)
+		}
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		createAndWriteTranslationLines(note.Translations, noteLevel+1, lineCounter, buf)
+	}
+}
@@ -0,0 +1,76 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+)
+
+// interpretLdsOrdinanceStructure interprets an LDS_INDIVIDUAL_ORDINANCE (BAPL, CONL, ENDL, SLGC)
+// or an LDS_SPOUSE_SEALING (SLGS)
+func interpretLdsOrdinanceStructure(ordinanceLines []*Line) *Gedcom_LdsOrdinance {
+	ordinance := &Gedcom_LdsOrdinance{}
+	forEachSubordinateLine(ordinanceLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "STAT":
+			ordinance.Status = subordinateLines[0].Value()
+			forEachSubordinateLine(subordinateLines, func(tag string, statusLines []*Line) {
+				switch tag {
+				case "DATE":
+					date := interpretDateStructure(statusLines[0])
+					gedcomIndividualDate := date.toGedcomIndividualDate()
+					ordinance.StatusChangeDate = &gedcomIndividualDate
+				}
+			})
+		case "DATE":
+			date := interpretDateStructure(subordinateLines[0])
+			gedcomIndividualDate := date.toGedcomIndividualDate()
+			ordinance.Date = &gedcomIndividualDate
+		case "TEMP":
+			ordinance.TempleCode = subordinateLines[0].Value()
+		case "PLAC":
+			ordinance.Place = subordinateLines[0].Value()
+		case "FAMC":
+			ordinance.FamilyId = subordinateLines[0].Value()
+		case "SOUR":
+			ordinance.SourceCitations = append(ordinance.SourceCitations, interpretSourceCitationStructure(subordinateLines))
+		case "NOTE", "SNOTE":
+			ordinance.Notes = append(ordinance.Notes, interpretNoteLinkStructure(subordinateLines))
+		}
+	})
+	return ordinance
+}
+
+func createAndWriteLdsOrdinanceLines(tag string, ordinances []*Gedcom_LdsOrdinance, ordinanceLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, ordinance := range ordinances {
+		err := createAndWriteLine(ordinanceLevel, "", tag, "", lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		detailLevel := ordinanceLevel + 1
+		if dateValue := toDateValue(ordinance.Date); dateValue != "" {
+			err := createAndWriteLine(detailLevel, "", "DATE", dateValue, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+		createAndWriteTagValueLines(detailLevel, []tagValue{
+			{"TEMP", ordinance.TempleCode},
+			{"PLAC", ordinance.Place},
+			{"FAMC", ordinance.FamilyId},
+		}, lineCounter, buf)
+		if ordinance.Status != "" {
+			err := createAndWriteLine(detailLevel, "", "STAT", ordinance.Status, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			} else if dateValue := toDateValue(ordinance.StatusChangeDate); dateValue != "" {
+				err := createAndWriteLine(detailLevel+1, "", "DATE", dateValue, lineCounter, buf)
+				if err != nil {
+					log.Println(err)
+				}
+			}
+		}
+		createAndWriteSourceCitationLines(ordinance.SourceCitations, detailLevel, lineCounter, buf)
+		createAndWriteNoteLinkLines(ordinance.Notes, detailLevel, lineCounter, buf)
+	}
+}
@@ -0,0 +1,104 @@
+package gedcom
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+var ordinanceLines = []string{
+	"0 HEAD",
+	"0 @I1@ INDI",
+	"1 NAME John /Doe/",
+	"1 BAPL",
+	"2 DATE 12 MAR 1952",
+	"2 TEMP SLAKE",
+	"2 PLAC Salt Lake City",
+	"2 STAT COMPLETED",
+	"3 DATE 1 APR 1952",
+	"1 ENDL",
+	"2 STAT CHILD",
+	"1 SLGC",
+	"2 FAMC @F1@",
+	"2 STAT BIC",
+	"2 NOTE Born in the covenant",
+	"0 @F1@ FAM",
+	"1 SLGS",
+	"2 DATE 1940",
+	"2 TEMP LOGAN",
+	"2 STAT DNS/CAN",
+	"0 TRLR",
+}
+
+var expectedBaptism = &Gedcom_LdsOrdinance{
+	Status:           "COMPLETED",
+	StatusChangeDate: &Gedcom_Individual_Date{Year: "1952", Month: "04", Day: "1"},
+	Date:             &Gedcom_Individual_Date{Year: "1952", Month: "03", Day: "12"},
+	TempleCode:       "SLAKE",
+	Place:            "Salt Lake City",
+}
+
+var expectedChildSealing = &Gedcom_LdsOrdinance{
+	Status:   "BIC",
+	FamilyId: "@F1@",
+	Notes:    []*Gedcom_NoteLink{{SubmitterText: "Born in the covenant"}},
+}
+
+var expectedSpouseSealing = &Gedcom_LdsOrdinance{
+	Status:     "DNS/CAN",
+	Date:       &Gedcom_Individual_Date{Year: "1940"},
+	TempleCode: "LOGAN",
+}
+
+func TestLdsOrdinanceStructure(t *testing.T) {
+	g := interpretGedcomLines(ordinanceLines)
+	assertLdsOrdinances(t, g)
+
+	buf, err := g.ToSerializedGedcom(nil)
+	if err != nil {
+		t.Fatalf("failed to serialize gedcom with error: %s", err)
+	}
+	assertLdsOrdinances(t, interpretGedcomLines(splitLines(buf.String())))
+}
+
+func assertLdsOrdinances(t *testing.T, g *ConcurrencySafeGedcom) {
+	if len(g.Individuals) != 1 || len(g.Families) != 1 {
+		t.Fatalf("expected one individual and one family, got %+v", &g.Gedcom)
+	}
+	i, f := g.Individuals[0], g.Families[0]
+	if len(i.LdsBaptisms) != 1 || !proto.Equal(i.LdsBaptisms[0], expectedBaptism) {
+		t.Errorf("result baptisms do not equal expected; result: %+v, expected %+v", i.LdsBaptisms, expectedBaptism)
+	}
+	if len(i.LdsEndowments) != 1 || i.LdsEndowments[0].Status != "CHILD" {
+		t.Errorf("unexpected endowments: %+v", i.LdsEndowments)
+	}
+	if len(i.LdsChildSealings) != 1 || !proto.Equal(i.LdsChildSealings[0], expectedChildSealing) {
+		t.Errorf("result child sealings do not equal expected; result: %+v, expected %+v", i.LdsChildSealings, expectedChildSealing)
+	}
+	if len(f.LdsSpouseSealings) != 1 || !proto.Equal(f.LdsSpouseSealings[0], expectedSpouseSealing) {
+		t.Errorf("result spouse sealings do not equal expected; result: %+v, expected %+v", f.LdsSpouseSealings, expectedSpouseSealing)
+	}
+}
+
+func TestLdsOrdinanceErrors(t *testing.T) {
+	testCases := []struct {
+		tag            string
+		ordinance      *Gedcom_LdsOrdinance
+		expectedErrors int
+	}{
+		{"BAPL", expectedBaptism, 0},
+		{"SLGS", expectedSpouseSealing, 0},
+		{"BAPL", &Gedcom_LdsOrdinance{Status: "DNS/CAN"}, 1},
+		{"BAPL", &Gedcom_LdsOrdinance{Status: "CHILD"}, 0},
+		{"BAPL", &Gedcom_LdsOrdinance{Status: "BIC"}, 1},
+		{"CONL", &Gedcom_LdsOrdinance{Status: "BIC"}, 1},
+		{"SLGC", &Gedcom_LdsOrdinance{Status: "BIC"}, 0},
+		{"ENDL", &Gedcom_LdsOrdinance{Status: "infant", TempleCode: "slake"}, 0},
+		{"SLGC", &Gedcom_LdsOrdinance{Status: "CANCELED", TempleCode: "HOGWA"}, 2},
+	}
+	for _, testCase := range testCases {
+		if errs := ldsOrdinanceErrors(testCase.tag, testCase.ordinance); len(errs) != testCase.expectedErrors {
+			t.Errorf("expected %d errors for %s ordinance %+v, got %v", testCase.expectedErrors, testCase.tag, testCase.ordinance, errs)
+		}
+	}
+}
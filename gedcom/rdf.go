@@ -0,0 +1,333 @@
+package gedcom
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+/*
+RDF output describes a gedcom with the schema.org vocabulary: individuals are schema:Person resources with their names,
+gender, birth and death, related to each other by schema:parent, schema:children and schema:spouse as their families
+determine, and to the sources they cite by schema:citation. Sources are schema:CreativeWork resources.
+The IRIs of records are their xRefIds without @s, appended to the base URI of the export options, so they're stable
+across exports. Places are blank nodes, as they have no identity in a gedcom.
+*/
+
+const (
+	// DefaultBaseURI is the base of the IRIs of records in RDF output without a base URI
+	DefaultBaseURI  = "urn:gedcom:"
+	schemaNamespace = "https://schema.org/"
+	xsdNamespace    = "http://www.w3.org/2001/XMLSchema#"
+)
+
+var rdfGendersByGenders = map[string]string{
+	"MALE":   schemaNamespace + "Male",
+	"FEMALE": schemaNamespace + "Female",
+}
+
+// rdfResource is a resource in RDF output, identified by its IRI or a blank node if it has none
+type rdfResource struct {
+	iri        string
+	rdfType    string
+	properties []*rdfProperty
+}
+
+// rdfProperty holds the values of a schema.org property of a resource
+type rdfProperty struct {
+	name   string
+	values []*rdfValue
+}
+
+// rdfValue is either an IRI, a literal of an XML schema datatype or a blank node
+type rdfValue struct {
+	iri      string
+	literal  string
+	datatype string
+	resource *rdfResource
+}
+
+// add adds values to a property of a resource, leaving out empty literals and IRIs the property already holds
+func (r *rdfResource) add(name string, values ...*rdfValue) {
+	var property *rdfProperty
+	for _, p := range r.properties {
+		if p.name == name {
+			property = p
+		}
+	}
+	for _, value := range values {
+		if value.iri == "" && value.literal == "" && value.resource == nil {
+			continue
+		}
+		if property == nil {
+			property = &rdfProperty{name: name}
+			r.properties = append(r.properties, property)
+		}
+		duplicate := false
+		for _, existing := range property.values {
+			duplicate = duplicate || value.iri != "" && existing.iri == value.iri
+		}
+		if !duplicate {
+			property.values = append(property.values, value)
+		}
+	}
+}
+
+func rdfLiteral(value string) *rdfValue {
+	return &rdfValue{literal: value}
+}
+
+func rdfIRI(iri string) *rdfValue {
+	return &rdfValue{iri: iri}
+}
+
+// rdfDate is a date typed as an XML schema date, year and month or year if it's formal, or else its GEDCOM value
+func rdfDate(date *Gedcom_Individual_Date) *rdfValue {
+	formal := strings.TrimPrefix(toGedcomXFormalDate(date), "+")
+	switch len(formal) {
+	case len("2006-01-02"):
+		return &rdfValue{literal: formal, datatype: "date"}
+	case len("2006-01"):
+		return &rdfValue{literal: formal, datatype: "gYearMonth"}
+	case len("2006"):
+		return &rdfValue{literal: formal, datatype: "gYear"}
+	}
+	return rdfLiteral(DateValue(date))
+}
+
+// characters IRIs can't hold, which would break out of Turtle IRI references
+const invalidIRICharacters = " <>\"{}|\\^`"
+
+// ParseBaseURI parses the base of the IRIs of records in RDF output, where an empty value is DefaultBaseURI.
+// The base has to be an absolute URI without characters IRIs can't hold, e.g. spaces or angle brackets.
+func ParseBaseURI(value string) (string, error) {
+	if value == "" {
+		return DefaultBaseURI, nil
+	}
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() || strings.ContainsAny(value, invalidIRICharacters) {
+		return DefaultBaseURI, fmt.Errorf("invalid base URI %s, expected an absolute URI, e.g. https://example.org/tree/", value)
+	}
+	return value, nil
+}
+
+// recordIRI derives the IRI of a record from its xRefId
+func recordIRI(baseURI string, id string) string {
+	return baseURI + url.PathEscape(strings.Trim(id, "@"))
+}
+
+// toRDFResources describes the individuals and sources of a gedcom as RDF resources, which fails for invalid base URIs
+func (g *ConcurrencySafeGedcom) toRDFResources(options *ExportOptions) ([]*rdfResource, error) {
+	baseURI := DefaultBaseURI
+	if options != nil {
+		var err error
+		baseURI, err = ParseBaseURI(options.BaseURI)
+		if err != nil {
+			return nil, err
+		}
+	}
+	gedcom := g.exportedGedcom(options)
+
+	resources := []*rdfResource{}
+	individualsByIds := map[string]*rdfResource{}
+	sourceIds := map[string]bool{}
+	for _, s := range gedcom.Sources {
+		sourceIds[s.Id] = true
+		source := &rdfResource{iri: recordIRI(baseURI, s.Id), rdfType: "CreativeWork"}
+		source.add("identifier", rdfLiteral(s.Id))
+		resources = append(resources, source)
+	}
+	for _, i := range gedcom.Individuals {
+		person := &rdfResource{iri: recordIRI(baseURI, i.Id), rdfType: "Person"}
+		individualsByIds[i.Id] = person
+		person.add("identifier", rdfLiteral(i.Id))
+		for n, name := range i.Names {
+			fullName := rdfLiteral(strings.TrimSpace(name.GivenName + " " + name.Surname))
+			if n > 0 {
+				person.add("alternateName", fullName)
+				continue
+			}
+			person.add("name", fullName)
+			person.add("givenName", rdfLiteral(name.GivenName))
+			person.add("familyName", rdfLiteral(name.Surname))
+		}
+		if gender, ok := rdfGendersByGenders[i.Gender]; ok {
+			person.add("gender", rdfIRI(gender))
+		}
+		for _, event := range []struct {
+			dateProperty  string
+			placeProperty string
+			events        []*Gedcom_Individual_Event
+		}{
+			{"birthDate", "birthPlace", i.BirthEvents},
+			{"deathDate", "deathPlace", i.DeathEvents},
+		} {
+			if len(event.events) == 0 {
+				continue
+			}
+			person.add(event.dateProperty, rdfDate(event.events[0].Date))
+			if place := event.events[0].Place; place != "" {
+				placeResource := &rdfResource{rdfType: "Place"}
+				placeResource.add("name", rdfLiteral(place))
+				person.add(event.placeProperty, &rdfValue{resource: placeResource})
+			}
+		}
+		resources = append(resources, person)
+	}
+
+	addCitations := func(person *rdfResource, citations []*Gedcom_SourceCitation) {
+		for _, citation := range citations {
+			if sourceIds[citation.SourceId] {
+				person.add("citation", rdfIRI(recordIRI(baseURI, citation.SourceId)))
+			}
+		}
+	}
+	for _, i := range gedcom.Individuals {
+		addCitations(individualsByIds[i.Id], SourceCitations(i))
+	}
+	for _, f := range gedcom.Families {
+		father, mother := individualsByIds[f.FatherId], individualsByIds[f.MotherId]
+		spouses := []*rdfResource{}
+		for _, spouse := range []*rdfResource{father, mother} {
+			if spouse != nil {
+				spouses = append(spouses, spouse)
+				// families are no resources of their own, so their citations are those of their spouses
+				addCitations(spouse, SourceCitations(f))
+			}
+		}
+		if father != nil && mother != nil {
+			father.add("spouse", rdfIRI(mother.iri))
+			mother.add("spouse", rdfIRI(father.iri))
+		}
+		for _, childId := range f.ChildIds {
+			child, ok := individualsByIds[childId]
+			if !ok {
+				continue
+			}
+			for _, spouse := range spouses {
+				child.add("parent", rdfIRI(spouse.iri))
+				spouse.add("children", rdfIRI(child.iri))
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].iri < resources[j].iri
+	})
+	return resources, nil
+}
+
+// ToTurtle exports a gedcom as RDF in the Turtle format
+func (g *ConcurrencySafeGedcom) ToTurtle(options *ExportOptions) (*[]byte, error) {
+	resources, err := g.toRDFResources(options)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer([]byte{})
+	buf.WriteString(fmt.Sprintf("@prefix schema: <%s> .\n", schemaNamespace))
+	buf.WriteString(fmt.Sprintf("@prefix xsd: <%s> .\n", xsdNamespace))
+	for _, resource := range resources {
+		buf.WriteString(fmt.Sprintf("\n%s a schema:%s", turtleIRI(resource.iri), resource.rdfType))
+		for _, property := range resource.properties {
+			values := []string{}
+			for _, value := range property.values {
+				values = append(values, turtleValue(value))
+			}
+			buf.WriteString(fmt.Sprintf(" ;\n\tschema:%s %s", property.name, strings.Join(values, ", ")))
+		}
+		buf.WriteString(" .\n")
+	}
+	turtle := buf.Bytes()
+	return &turtle, nil
+}
+
+func turtleValue(value *rdfValue) string {
+	switch {
+	case value.resource != nil:
+		properties := []string{"a schema:" + value.resource.rdfType}
+		for _, property := range value.resource.properties {
+			values := []string{}
+			for _, v := range property.values {
+				values = append(values, turtleValue(v))
+			}
+			properties = append(properties, fmt.Sprintf("schema:%s %s", property.name, strings.Join(values, ", ")))
+		}
+		return "[ " + strings.Join(properties, " ; ") + " ]"
+	case value.iri != "":
+		return turtleIRI(value.iri)
+	case value.datatype != "":
+		return turtleString(value.literal) + "^^xsd:" + value.datatype
+	}
+	return turtleString(value.literal)
+}
+
+// turtleIRI abbreviates schema.org IRIs to their prefixed names
+func turtleIRI(iri string) string {
+	if strings.HasPrefix(iri, schemaNamespace) {
+		return "schema:" + strings.TrimPrefix(iri, schemaNamespace)
+	}
+	return "<" + iri + ">"
+}
+
+// turtleString quotes a string as a Turtle string literal
+func turtleString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	value = strings.ReplaceAll(value, "\r", `\r`)
+	return `"` + value + `"`
+}
+
+// ToJSONLD exports a gedcom as RDF in the JSON-LD format, as a graph of node objects using schema.org as vocabulary
+func (g *ConcurrencySafeGedcom) ToJSONLD(options *ExportOptions) (*[]byte, error) {
+	resources, err := g.toRDFResources(options)
+	if err != nil {
+		return nil, err
+	}
+	graph := []map[string]interface{}{}
+	for _, resource := range resources {
+		graph = append(graph, jsonLDNode(resource))
+	}
+	jsonLD, err := json.Marshal(map[string]interface{}{
+		"@context": map[string]string{
+			"@vocab": schemaNamespace,
+			"xsd":    xsdNamespace,
+		},
+		"@graph": graph,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &jsonLD, nil
+}
+
+func jsonLDNode(resource *rdfResource) map[string]interface{} {
+	node := map[string]interface{}{"@type": resource.rdfType}
+	if resource.iri != "" {
+		node["@id"] = resource.iri
+	}
+	for _, property := range resource.properties {
+		values := []interface{}{}
+		for _, value := range property.values {
+			switch {
+			case value.resource != nil:
+				values = append(values, jsonLDNode(value.resource))
+			case value.iri != "":
+				values = append(values, map[string]string{"@id": value.iri})
+			case value.datatype != "":
+				values = append(values, map[string]string{"@value": value.literal, "@type": "xsd:" + value.datatype})
+			default:
+				values = append(values, value.literal)
+			}
+		}
+		if len(values) == 1 {
+			node[property.name] = values[0]
+		} else {
+			node[property.name] = values
+		}
+	}
+	return node
+}
@@ -0,0 +1,131 @@
+package gedcom
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var rdfTestLines = []string{
+	"0 HEAD",
+	"0 @I1@ INDI",
+	"1 NAME John /Smith/",
+	"1 NAME Johnny",
+	"1 SEX M",
+	"1 BIRT",
+	"2 DATE 1 JAN 1900",
+	"2 PLAC London, \"England\"",
+	"1 DEAT",
+	"2 DATE 1980/81",
+	"1 ASSO @I3@",
+	"2 SOUR @S1@",
+	"0 @I2@ INDI",
+	"1 NAME Mary /Jones/",
+	"1 SEX F",
+	"0 @I3@ INDI",
+	"1 NAME Jim /Smith/",
+	"1 BIRT",
+	"2 DATE MAR 1925",
+	"0 @F1@ FAM",
+	"1 HUSB @I1@",
+	"1 WIFE @I2@",
+	"1 CHIL @I3@",
+	"0 @S1@ SOUR",
+	"0 TRLR",
+}
+
+func TestToTurtle(t *testing.T) {
+	g := interpretGedcomLines(rdfTestLines)
+	turtle, err := g.ToTurtle(&ExportOptions{BaseURI: "https://example.org/tree/"})
+	if err != nil {
+		t.Fatalf("failed to export Turtle with error: %s", err)
+	}
+	result := string(*turtle)
+	for _, expected := range []string{
+		"@prefix schema: <https://schema.org/> .\n",
+		"\n<https://example.org/tree/I1> a schema:Person ;\n\tschema:identifier \"@I1@\" ;\n\tschema:name \"John Smith\" ;\n\tschema:givenName \"John\" ;\n\tschema:familyName \"Smith\" ;\n\tschema:alternateName \"Johnny\" ;\n\tschema:gender schema:Male ;\n",
+		"\tschema:birthDate \"1900-01-01\"^^xsd:date ;\n\tschema:birthPlace [ a schema:Place ; schema:name \"London, \\\"England\\\"\" ] ;\n\tschema:deathDate \"1980/81\" ;\n",
+		"\tschema:citation <https://example.org/tree/S1> ;\n",
+		"\tschema:spouse <https://example.org/tree/I2> ;\n\tschema:children <https://example.org/tree/I3> .\n",
+		"\tschema:birthDate \"1925-03\"^^xsd:gYearMonth ;\n\tschema:parent <https://example.org/tree/I1>, <https://example.org/tree/I2> .\n",
+		"\n<https://example.org/tree/S1> a schema:CreativeWork ;\n\tschema:identifier \"@S1@\" .\n",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Errorf("expected Turtle to contain %q, found %s", expected, result)
+		}
+	}
+}
+
+func TestToJSONLD(t *testing.T) {
+	g := interpretGedcomLines(rdfTestLines)
+	jsonLD, err := g.ToJSONLD(nil)
+	if err != nil {
+		t.Fatalf("failed to export JSON-LD with error: %s", err)
+	}
+	result := struct {
+		Context map[string]string        `json:"@context"`
+		Graph   []map[string]interface{} `json:"@graph"`
+	}{}
+	if err := json.Unmarshal(*jsonLD, &result); err != nil {
+		t.Fatalf("failed to read JSON-LD with error: %s", err)
+	}
+	if result.Context["@vocab"] != "https://schema.org/" {
+		t.Errorf("expected schema.org as vocabulary, found %v", result.Context)
+	}
+	nodes := map[string]map[string]interface{}{}
+	for _, node := range result.Graph {
+		nodes[node["@id"].(string)] = node
+	}
+	john := nodes["urn:gedcom:I1"]
+	expected := map[string]interface{}{
+		"@type":     "Person",
+		"name":      "John Smith",
+		"gender":    map[string]interface{}{"@id": "https://schema.org/Male"},
+		"birthDate": map[string]interface{}{"@value": "1900-01-01", "@type": "xsd:date"},
+		"spouse":    map[string]interface{}{"@id": "urn:gedcom:I2"},
+		"citation":  map[string]interface{}{"@id": "urn:gedcom:S1"},
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(john[key], value) {
+			t.Errorf("expected %s of John to be %v, found %v", key, value, john[key])
+		}
+	}
+	expectedParents := []interface{}{
+		map[string]interface{}{"@id": "urn:gedcom:I1"},
+		map[string]interface{}{"@id": "urn:gedcom:I2"},
+	}
+	if parents := nodes["urn:gedcom:I3"]["parent"]; !reflect.DeepEqual(parents, expectedParents) {
+		t.Errorf("expected parents %v, found %v", expectedParents, parents)
+	}
+}
+
+func TestParseBaseURI(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+		valid    bool
+	}{
+		{"", DefaultBaseURI, true},
+		{"https://example.org/tree/", "https://example.org/tree/", true},
+		{"urn:family:", "urn:family:", true},
+		{"tree/", DefaultBaseURI, false},
+		{"https://example.org/my tree/", DefaultBaseURI, false},
+		{"https://example.org/> <https://evil.org/", DefaultBaseURI, false},
+		{"https://example.org/\n", DefaultBaseURI, false},
+	}
+	for _, c := range cases {
+		baseURI, err := ParseBaseURI(c.value)
+		if baseURI != c.expected || (err == nil) != c.valid {
+			t.Errorf("ParseBaseURI(%q) = %q, %v", c.value, baseURI, err)
+		}
+	}
+
+	g := interpretGedcomLines(rdfTestLines)
+	if _, err := g.ToTurtle(&ExportOptions{BaseURI: "https://example.org/> ."}); err == nil {
+		t.Errorf("expected Turtle export with an invalid base URI to fail")
+	}
+	if _, err := g.ToJSONLD(&ExportOptions{BaseURI: "tree/"}); err == nil {
+		t.Errorf("expected JSON-LD export with an invalid base URI to fail")
+	}
+}
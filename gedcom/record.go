@@ -0,0 +1,93 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+)
+
+// recordIdentification holds the identifiers and change tracking data that every record can have
+type recordIdentification struct {
+	userReferences    []*Gedcom_UserReference
+	automatedRecordId string
+	uniqueIds         []string
+	changeDate        *Gedcom_ChangeDate
+	externalIds       []*Gedcom_ExternalId
+}
+
+// interpretRecordIdentification interprets the REFN, RIN, UID, _UID, EXID, _EXID and CHAN lines of a record
+func interpretRecordIdentification(recordLines []*Line) recordIdentification {
+	identification := recordIdentification{}
+	forEachSubordinateLine(recordLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "REFN":
+			userReference := &Gedcom_UserReference{
+				Number: subordinateLines[0].Value(),
+			}
+			forEachSubordinateLine(subordinateLines, func(tag string, typeLines []*Line) {
+				switch tag {
+				case "TYPE":
+					userReference.Type = typeLines[0].Value()
+				}
+			})
+			identification.userReferences = append(identification.userReferences, userReference)
+		case "RIN":
+			identification.automatedRecordId = subordinateLines[0].Value()
+		case "UID", "_UID":
+			identification.uniqueIds = append(identification.uniqueIds, subordinateLines[0].Value())
+		case "EXID", "_EXID":
+			externalId := &Gedcom_ExternalId{
+				Id: subordinateLines[0].Value(),
+			}
+			forEachSubordinateLine(subordinateLines, func(tag string, typeLines []*Line) {
+				switch tag {
+				case "TYPE":
+					externalId.Type = typeLines[0].Value()
+				}
+			})
+			identification.externalIds = append(identification.externalIds, externalId)
+		case "CHAN":
+			identification.changeDate = interpretChangeDateStructure(subordinateLines)
+		}
+	})
+	return identification
+}
+
+// createAndWriteRecordIdentificationLines writes the REFN, RIN, _UID, _EXID and CHAN lines of a record.
+// UID and EXID are GEDCOM 7.0 tags, which aren't defined by GEDCOM 5.5.1, so unique and external ids read from either
+// tag are normalised to the user-defined _UID and _EXID tags, and written as UID and EXID again in GEDCOM 7.0 output.
+func createAndWriteRecordIdentificationLines(identification recordIdentification, level int, lineCounter *int, buf *bytes.Buffer) {
+	for _, userReference := range identification.userReferences {
+		err := createAndWriteLine(level, "", "REFN", userReference.Number, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if userReference.Type != "" {
+			err := createAndWriteLine(level+1, "", "TYPE", userReference.Type, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	if identification.automatedRecordId != "" {
+		err := createAndWriteLine(level, "", "RIN", identification.automatedRecordId, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	createAndWriteValueLines(level, "_UID", identification.uniqueIds, lineCounter, buf)
+	for _, externalId := range identification.externalIds {
+		err := createAndWriteLine(level, "", "_EXID", externalId.Id, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if externalId.Type != "" {
+			err := createAndWriteLine(level+1, "", "TYPE", externalId.Type, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	createAndWriteChangeDateLines(identification.changeDate, level, lineCounter, buf)
+}
@@ -0,0 +1,170 @@
+package gedcom
+
+import (
+	"strings"
+)
+
+// values of restriction notices (RESN)
+const (
+	RestrictionConfidential = "confidential"
+	RestrictionLocked       = "locked"
+	RestrictionPrivacy      = "privacy"
+)
+
+// hasRestriction reports whether a restriction notice holds the given restriction.
+// Restriction notices are compared case-insensitively and can hold multiple comma-separated restrictions.
+func hasRestriction(restrictionNotice string, restriction string) bool {
+	for _, r := range strings.Split(restrictionNotice, ",") {
+		if strings.EqualFold(strings.TrimSpace(r), restriction) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRestrictions omits all records and events restricted as confidential
+// and redacts all records and events restricted for privacy, keeping only their place in the tree.
+func applyRestrictions(gedcom *Gedcom) {
+	confidentialIndividualIds := map[string]bool{}
+	for i, indi := range gedcom.Individuals {
+		if hasRestriction(indi.Restriction, RestrictionConfidential) {
+			confidentialIndividualIds[indi.Id] = true
+			continue
+		}
+		if hasRestriction(indi.Restriction, RestrictionPrivacy) {
+			gedcom.Individuals[i] = &Gedcom_Individual{
+				Id:                  indi.Id,
+				Restriction:         indi.Restriction,
+				ChildToFamilyLinks:  familyLinkPointers(indi.ChildToFamilyLinks),
+				SpouseToFamilyLinks: familyLinkPointers(indi.SpouseToFamilyLinks),
+			}
+			continue
+		}
+		indi.BirthEvents = restrictedEvents(indi.BirthEvents)
+		indi.DeathEvents = restrictedEvents(indi.DeathEvents)
+		indi.Residences = restrictedEvents(indi.Residences)
+		indi.BurialEvents = restrictedEvents(indi.BurialEvents)
+	}
+
+	confidentialFamilyIds := map[string]bool{}
+	for i, f := range gedcom.Families {
+		if hasRestriction(f.Restriction, RestrictionConfidential) {
+			confidentialFamilyIds[f.Id] = true
+			continue
+		}
+		if hasRestriction(f.Restriction, RestrictionPrivacy) {
+			gedcom.Families[i] = &Gedcom_Family{
+				Id:          f.Id,
+				FatherId:    f.FatherId,
+				MotherId:    f.MotherId,
+				ChildIds:    f.ChildIds,
+				Restriction: f.Restriction,
+			}
+			continue
+		}
+		f.MarriageEvents = restrictedEvents(f.MarriageEvents)
+	}
+
+	removeIndividuals(gedcom, confidentialIndividualIds)
+	removeFamilies(gedcom, confidentialFamilyIds)
+}
+
+// restrictedEvents omits confidential events and redacts the details of events restricted for privacy
+func restrictedEvents(events []*Gedcom_Individual_Event) []*Gedcom_Individual_Event {
+	result := []*Gedcom_Individual_Event{}
+	for _, event := range events {
+		if hasRestriction(event.Restriction, RestrictionConfidential) {
+			continue
+		}
+		if hasRestriction(event.Restriction, RestrictionPrivacy) {
+			event = &Gedcom_Individual_Event{
+				Primary:     event.Primary,
+				Restriction: event.Restriction,
+			}
+		}
+		result = append(result, event)
+	}
+	return result
+}
+
+// removeIndividuals removes the individuals with the given ids along with all references to them,
+// so the remaining families, associations and aliases stay consistent.
+func removeIndividuals(gedcom *Gedcom, ids map[string]bool) {
+	if len(ids) == 0 {
+		return
+	}
+
+	individuals := []*Gedcom_Individual{}
+	for _, indi := range gedcom.Individuals {
+		if ids[indi.Id] {
+			continue
+		}
+		associations := []*Gedcom_Individual_Association{}
+		for _, association := range indi.Associations {
+			if !ids[association.IndividualId] {
+				associations = append(associations, association)
+			}
+		}
+		indi.Associations = associations
+		aliases := []string{}
+		for _, alias := range indi.Aliases {
+			if !ids[alias] {
+				aliases = append(aliases, alias)
+			}
+		}
+		indi.Aliases = aliases
+		individuals = append(individuals, indi)
+	}
+	gedcom.Individuals = individuals
+
+	for _, f := range gedcom.Families {
+		if ids[f.FatherId] {
+			f.FatherId = ""
+		}
+		if ids[f.MotherId] {
+			f.MotherId = ""
+		}
+		childIds := []string{}
+		for _, childId := range f.ChildIds {
+			if !ids[childId] {
+				childIds = append(childIds, childId)
+			}
+		}
+		f.ChildIds = childIds
+	}
+}
+
+// removeFamilies removes the families with the given ids along with all references to them
+func removeFamilies(gedcom *Gedcom, ids map[string]bool) {
+	if len(ids) == 0 {
+		return
+	}
+
+	families := []*Gedcom_Family{}
+	for _, f := range gedcom.Families {
+		if !ids[f.Id] {
+			families = append(families, f)
+		}
+	}
+	gedcom.Families = families
+
+	for _, indi := range gedcom.Individuals {
+		indi.ChildToFamilyLinks = familyLinksExcept(indi.ChildToFamilyLinks, ids)
+		indi.SpouseToFamilyLinks = familyLinksExcept(indi.SpouseToFamilyLinks, ids)
+		for _, sealing := range indi.LdsChildSealings {
+			if ids[sealing.FamilyId] {
+				sealing.FamilyId = ""
+			}
+		}
+	}
+}
+
+func familyLinksExcept(familyLinks []*Gedcom_Individual_FamilyLink, familyIds map[string]bool) []*Gedcom_Individual_FamilyLink {
+	var result []*Gedcom_Individual_FamilyLink
+	for _, familyLink := range familyLinks {
+		if !familyIds[familyLink.FamilyId] {
+			result = append(result, familyLink)
+		}
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+package gedcom
+
+import (
+	"strings"
+	"testing"
+)
+
+var restrictedLines = []string{
+	"0 HEAD",
+	"0 @I1@ INDI",
+	"1 NAME Harry /Potter/",
+	"1 BIRT",
+	"2 DATE 31 JUL 1980",
+	"2 PLAC Godric's Hollow",
+	"2 RESN privacy",
+	"1 RESI",
+	"2 PLAC 4 Privet Drive",
+	"2 RESN confidential",
+	"0 @I2@ INDI",
+	"1 NAME Tom /Riddle/",
+	"1 RESN confidential, locked",
+	"0 @I3@ INDI",
+	"1 NAME Ginny /Weasley/",
+	"1 RESN privacy",
+	"1 BIRT",
+	"2 DATE 11 AUG 1981",
+	"0 @I4@ INDI",
+	"1 NAME Delphini /Riddle/",
+	"1 ASSO @I2@",
+	"2 RELA Father",
+	"1 SLGC",
+	"2 FAMC @F2@",
+	"0 @F1@ FAM",
+	"1 HUSB @I1@",
+	"1 WIFE @I3@",
+	"0 @F2@ FAM",
+	"1 RESN confidential",
+	"1 HUSB @I2@",
+	"1 CHIL @I4@",
+	"0 @F3@ FAM",
+	"1 HUSB @I2@",
+	"1 CHIL @I4@",
+	"0 TRLR",
+}
+
+func TestHasRestriction(t *testing.T) {
+	if !hasRestriction("Confidential, locked", RestrictionConfidential) || !hasRestriction("confidential, LOCKED", RestrictionLocked) {
+		t.Errorf("expected restrictions in comma-separated restriction notice")
+	}
+	if hasRestriction("privacy", RestrictionConfidential) || hasRestriction("", RestrictionPrivacy) {
+		t.Errorf("unexpected restriction")
+	}
+}
+
+func TestApplyRestrictions(t *testing.T) {
+	g := interpretGedcomLines(restrictedLines)
+	buf, err := g.ToSerializedGedcom(&ExportOptions{ApplyRestrictions: true})
+	if err != nil {
+		t.Fatalf("failed to serialize gedcom with error: %s", err)
+	}
+	if strings.Contains(buf.String(), "Tom") || strings.Contains(buf.String(), "Ginny") ||
+		strings.Contains(buf.String(), "Privet") || strings.Contains(buf.String(), "Godric") {
+		t.Errorf("expected restricted data to be left out of the export, got:\n%s", buf.String())
+	}
+
+	restricted := interpretGedcomLines(splitLines(buf.String()))
+	individuals, families := restricted.IndividualsByIds(), restricted.FamiliesByIds()
+	if _, ok := individuals["@I2@"]; ok {
+		t.Errorf("expected confidential individual to be omitted")
+	}
+	if ginny, ok := individuals["@I3@"]; !ok || len(ginny.Names) != 0 || len(ginny.BirthEvents) != 0 {
+		t.Errorf("expected private individual to be redacted, got %+v", ginny)
+	}
+	if harry := individuals["@I1@"]; len(harry.Names) != 1 || len(harry.Residences) != 0 ||
+		len(harry.BirthEvents) != 1 || harry.BirthEvents[0].Date.GetYear() != "" {
+		t.Errorf("expected confidential event to be omitted and private event to be redacted, got %+v", harry)
+	}
+	if delphini := individuals["@I4@"]; len(delphini.Associations) != 0 || delphini.LdsChildSealings[0].FamilyId != "" {
+		t.Errorf("expected references to omitted records to be removed, got %+v", delphini)
+	}
+	if _, ok := families["@F2@"]; ok {
+		t.Errorf("expected confidential family to be omitted")
+	}
+	if f := families["@F1@"]; f.FatherId != "@I1@" || f.MotherId != "@I3@" {
+		t.Errorf("expected tree structure of private individuals to be kept, got %+v", f)
+	}
+	if f := families["@F3@"]; f.FatherId != "" || len(f.ChildIds) != 1 {
+		t.Errorf("expected references to confidential individuals to be removed, got %+v", f)
+	}
+
+	if len(g.Individuals) != 4 || len(g.Families) != 3 {
+		t.Errorf("expected export to leave interpreted gedcom unchanged")
+	}
+}
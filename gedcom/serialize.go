@@ -3,28 +3,109 @@ package gedcom
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"github.com/golang/protobuf/proto"
 	"github.com/jochenboesmans/gedcom-parser/util"
+	"gopkg.in/yaml.v3"
 	"log"
+	"strings"
 )
 
-func (gedcom *ConcurrencySafeGedcom) ToJson() (*[]byte, error) {
-	gedcomJson, err := json.Marshal(&gedcom.Gedcom)
+// ExportOptions determine which data of a gedcom gets exported, nil options export all data
+type ExportOptions struct {
+	// ApplyRestrictions omits data restricted as confidential and redacts data restricted for privacy by restriction notices (RESN)
+	ApplyRestrictions bool
+	// Living determines what happens to individuals who might still be alive
+	Living LivingPolicy
+	// LivingMaxAge is the age in years from which individuals without a death event are presumed dead, defaults to DefaultLivingMaxAge
+	LivingMaxAge int
+	// Version is the version of the GEDCOM standard ToSerializedGedcom writes, defaults to GEDCOM 5.5.1
+	Version GedcomVersion
+	// PackageMedia packages the local media files referenced by multimedia files (OBJE FILE) into GEDZIP archives
+	PackageMedia bool
+	// MediaDirectory is the directory relative media file references are resolved against when packaging media
+	MediaDirectory string
+	// JSONFormat is the format of JSON files, files named *.gedx.json are always GEDCOM X JSON
+	JSONFormat JSONFormat
+	// CSVDelimiter is the delimiter of the CSV files ToCSV writes, defaults to a comma
+	CSVDelimiter rune
+	// Graph determines which part of a gedcom ToDot renders and how, nil renders every individual
+	Graph *GraphOptions
+	// BaseURI is the base of the IRIs RDF output derives from xRefIds, defaults to DefaultBaseURI.
+	// RDF exports fail for base URIs ParseBaseURI rejects.
+	BaseURI string
+}
+
+// exportedGedcom returns the gedcom to export given the export options.
+// The gedcom is copied before being altered, so exporting never changes the interpreted gedcom.
+func (g *ConcurrencySafeGedcom) exportedGedcom(options *ExportOptions) *Gedcom {
+	if options == nil || !options.ApplyRestrictions && options.Living == LivingKeep {
+		return &g.Gedcom
+	}
+	exported := proto.Clone(&g.Gedcom).(*Gedcom)
+	if options.ApplyRestrictions {
+		applyRestrictions(exported)
+	}
+	applyLivingPolicy(exported, options.Living, options.LivingMaxAge)
+	return exported
+}
+
+// Exported returns the gedcom to export given the export options, for exporters outside of this package
+func (g *ConcurrencySafeGedcom) Exported(options *ExportOptions) *Gedcom {
+	return g.exportedGedcom(options)
+}
+
+func (g *ConcurrencySafeGedcom) ToJson(options *ExportOptions) (*[]byte, error) {
+	gedcomJson, err := json.Marshal(g.exportedGedcom(options))
 	if err != nil {
 		return nil, err
 	}
 	return &gedcomJson, nil
 }
 
-func (gedcom *ConcurrencySafeGedcom) ToProto() (*[]byte, error) {
-	gedcomProto, err := proto.Marshal(&gedcom.Gedcom)
+func (g *ConcurrencySafeGedcom) ToProto(options *ExportOptions) (*[]byte, error) {
+	gedcomProto, err := proto.Marshal(g.exportedGedcom(options))
 	if err != nil {
 		return nil, err
 	}
 	return &gedcomProto, nil
 }
 
+// ToXML writes the XML representation of a gedcom, of which gedcom.xsd is the schema
+func (g *ConcurrencySafeGedcom) ToXML(options *ExportOptions) (*[]byte, error) {
+	buf := bytes.NewBufferString(xml.Header)
+	encoder := xml.NewEncoder(buf)
+	encoder.Indent("", "  ")
+	err := writeXMLMessage(encoder, xmlRootElement, g.exportedGedcom(options).ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	err = encoder.Flush()
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	gedcomXML := buf.Bytes()
+	return &gedcomXML, nil
+}
+
+func (g *ConcurrencySafeGedcom) ToYAML(options *ExportOptions) (*[]byte, error) {
+	buf := bytes.NewBuffer([]byte{})
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	err := encoder.Encode(yamlMessageNode(g.exportedGedcom(options).ProtoReflect()))
+	if err != nil {
+		return nil, err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+	gedcomYAML := buf.Bytes()
+	return &gedcomYAML, nil
+}
+
 func writeLine(gedcomFields *GedcomFields, buf *bytes.Buffer, lineCounter *int) error {
 	lineString, err := gedcomFields.ToLine()
 	if err != nil {
@@ -46,50 +127,49 @@ func createAndWriteLine(level int, xRefID string, tag string, value string, line
 	return err
 }
 
-func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
-	gedcom := g.Gedcom
-	buf := bytes.NewBuffer([]byte{})
-	lineCounter := 0
-	rootLevel := 0
+type tagValue struct {
+	tag   string
+	value string
+}
 
-	err := createAndWriteLine(rootLevel, "", "HEAD", "", &lineCounter, buf)
-	if err != nil {
-		// completely fail write if header write fails
-		return nil, err
-	}
-	if gedcom.Header.Source != "" {
-		headerSourceLevel := rootLevel + 1
-		err := createAndWriteLine(headerSourceLevel, "", "SOUR", gedcom.Header.Source, &lineCounter, buf)
-		if err != nil {
-			log.Println(err)
+// createAndWriteTagValueLines writes a line for every tag with a non-empty value
+func createAndWriteTagValueLines(level int, tagValues []tagValue, lineCounter *int, buf *bytes.Buffer) {
+	for _, tv := range tagValues {
+		if tv.value == "" {
+			continue
 		}
-	}
-	if gedcom.Header.Submitter != "" {
-		headerSubmitterLevel := rootLevel + 1
-		err := createAndWriteLine(headerSubmitterLevel, "", "SUBM", gedcom.Header.Submitter, &lineCounter, buf)
+		err := createAndWriteLine(level, "", tv.tag, tv.value, lineCounter, buf)
 		if err != nil {
 			log.Println(err)
 		}
 	}
-	if gedcom.Header.GedcomMetaData.VersionNumber != "" {
-		headerGedcomMetaDataLevel := rootLevel + 1
-		err := createAndWriteLine(headerGedcomMetaDataLevel, "", "GEDC", gedcom.Header.GedcomMetaData.VersionNumber, &lineCounter, buf)
+}
+
+// createAndWriteValueLines writes a line with the given tag for every value
+func createAndWriteValueLines(level int, tag string, values []string, lineCounter *int, buf *bytes.Buffer) {
+	for _, value := range values {
+		err := createAndWriteLine(level, "", tag, value, lineCounter, buf)
 		if err != nil {
 			log.Println(err)
-		} else {
-			err := createAndWriteLine(headerGedcomMetaDataLevel, "", "FORM", gedcom.Header.GedcomMetaData.GedcomForm, &lineCounter, buf)
-			if err != nil {
-				log.Println(err)
-			}
 		}
 	}
-	if gedcom.Header.CharacterSet != "" {
-		headerCharacterSetLevel := rootLevel + 1
-		err := createAndWriteLine(headerCharacterSetLevel, "", "CHAR", gedcom.Header.CharacterSet, &lineCounter, buf)
-		if err != nil {
-			log.Println(err)
-		}
+}
+
+func (g *ConcurrencySafeGedcom) ToSerializedGedcom(options *ExportOptions) (*bytes.Buffer, error) {
+	gedcom := g.exportedGedcom(options)
+	if options == nil || options.Version != GedcomVersion7 {
+		gedcom = withoutVoidPointers(gedcom)
+	}
+	buf := bytes.NewBuffer([]byte{})
+	lineCounter := 0
+	rootLevel := 0
+
+	err := createAndWriteLine(rootLevel, "", "HEAD", "", &lineCounter, buf)
+	if err != nil {
+		// completely fail write if header write fails
+		return nil, err
 	}
+	createAndWriteHeaderLines(gedcom.Header, rootLevel+1, &lineCounter, buf)
 
 	for _, i := range gedcom.Individuals {
 		indiLevel := rootLevel
@@ -128,6 +208,7 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 					log.Println(err)
 				}
 			}
+			createAndWriteTranslationLines(n.Translations, nameLevel+1, &lineCounter, buf)
 		}
 
 		for _, b := range i.BirthEvents {
@@ -150,6 +231,33 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 			createAndWriteDeepEventLines(d, eventLevel, &lineCounter, buf)
 		}
 
+		for _, b := range i.BurialEvents {
+			eventLevel := indiLevel + 1
+			err := createAndWriteLine(eventLevel, "", "BURI", "", &lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			createAndWriteDeepEventLines(b, eventLevel, &lineCounter, buf)
+		}
+
+		for _, r := range i.Residences {
+			eventLevel := indiLevel + 1
+			err := createAndWriteLine(eventLevel, "", "RESI", "", &lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			createAndWriteDeepEventLines(r, eventLevel, &lineCounter, buf)
+		}
+
+		if i.Restriction != "" {
+			err := createAndWriteLine(indiLevel+1, "", "RESN", i.Restriction, &lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+
 		if genderLetter, hit := util.GenderLetterByFull[i.Gender]; hit {
 			genderLevel := indiLevel + 1
 			err := createAndWriteLine(genderLevel, "", "SEX", genderLetter, &lineCounter, buf)
@@ -157,6 +265,17 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 				log.Println(err)
 			}
 		}
+		createAndWriteFamilyLinkLines("FAMC", i.ChildToFamilyLinks, indiLevel+1, &lineCounter, buf)
+		createAndWriteFamilyLinkLines("FAMS", i.SpouseToFamilyLinks, indiLevel+1, &lineCounter, buf)
+		createAndWriteValueLines(indiLevel+1, "ALIA", i.Aliases, &lineCounter, buf)
+		createAndWriteAssociationLines(i.Associations, indiLevel+1, &lineCounter, buf)
+		createAndWriteLdsOrdinanceLines("BAPL", i.LdsBaptisms, indiLevel+1, &lineCounter, buf)
+		createAndWriteLdsOrdinanceLines("CONL", i.LdsConfirmations, indiLevel+1, &lineCounter, buf)
+		createAndWriteLdsOrdinanceLines("ENDL", i.LdsEndowments, indiLevel+1, &lineCounter, buf)
+		createAndWriteLdsOrdinanceLines("SLGC", i.LdsChildSealings, indiLevel+1, &lineCounter, buf)
+		createAndWriteNonEventLines(i.NonEvents, indiLevel+1, &lineCounter, buf)
+		createAndWriteStructureLinkLines(structureLinks{i.SourceCitations, i.Notes, i.MultimediaLinks}, indiLevel+1, &lineCounter, buf)
+		createAndWriteRecordIdentificationLines(recordIdentification{i.UserReferences, i.AutomatedRecordId, i.UniqueIds, i.ChangeDate, i.ExternalIds}, indiLevel+1, &lineCounter, buf)
 	}
 
 	for _, f := range gedcom.Families {
@@ -167,6 +286,12 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 			continue
 		}
 
+		if f.Restriction != "" {
+			err := createAndWriteLine(familyLevel+1, "", "RESN", f.Restriction, &lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
 		if f.FatherId != "" {
 			fatherLevel := familyLevel + 1
 			err := createAndWriteLine(fatherLevel, "", "HUSB", f.FatherId, &lineCounter, buf)
@@ -190,10 +315,23 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 				continue
 			}
 		}
+		for _, m := range f.MarriageEvents {
+			eventLevel := familyLevel + 1
+			err := createAndWriteLine(eventLevel, "", "MARR", "", &lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			createAndWriteDeepEventLines(m, eventLevel, &lineCounter, buf)
+		}
+		createAndWriteLdsOrdinanceLines("SLGS", f.LdsSpouseSealings, familyLevel+1, &lineCounter, buf)
+		createAndWriteNonEventLines(f.NonEvents, familyLevel+1, &lineCounter, buf)
+		createAndWriteStructureLinkLines(structureLinks{f.SourceCitations, f.Notes, f.MultimediaLinks}, familyLevel+1, &lineCounter, buf)
+		createAndWriteRecordIdentificationLines(recordIdentification{f.UserReferences, f.AutomatedRecordId, f.UniqueIds, f.ChangeDate, f.ExternalIds}, familyLevel+1, &lineCounter, buf)
 	}
 
 	multimediaLevel := rootLevel
-	for _, multimedia := range g.Multimedias {
+	for _, multimedia := range gedcom.Multimedias {
 		err := createAndWriteLine(multimediaLevel, multimedia.Id, "OBJE", "", &lineCounter, buf)
 		if err != nil {
 			log.Println(err)
@@ -201,32 +339,26 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 		}
 
 		for _, file := range multimedia.Files {
-			fileLevel := multimediaLevel + 1
-			err := createAndWriteLine(fileLevel, "", "FILE", file.Reference, &lineCounter, buf)
-			if err != nil {
-				log.Println(err)
-			}
-			if file.Format != "" {
-				formatLevel := fileLevel + 1
-				err := createAndWriteLine(formatLevel, "", "FORM", file.Format, &lineCounter, buf)
-				if err != nil {
-					log.Println(err)
-				}
-			}
+			createAndWriteMultimediaFileLines(file, multimediaLevel+1, &lineCounter, buf)
 		}
+		createAndWriteNoteLinkLines(multimedia.Notes, multimediaLevel+1, &lineCounter, buf)
+		createAndWriteSourceCitationLines(multimedia.SourceCitations, multimediaLevel+1, &lineCounter, buf)
+		createAndWriteRecordIdentificationLines(recordIdentification{multimedia.UserReferences, multimedia.AutomatedRecordId, multimedia.UniqueIds, multimedia.ChangeDate, multimedia.ExternalIds}, multimediaLevel+1, &lineCounter, buf)
 	}
 
 	noteLevel := rootLevel
-	for _, note := range g.Notes {
-		err := createAndWriteLine(noteLevel, note.Id, "NOTE", note.SubmitterText, &lineCounter, buf)
+	for _, note := range gedcom.Notes {
+		err := createAndWriteTextLines(noteLevel, note.Id, "NOTE", note.SubmitterText, &lineCounter, buf)
 		if err != nil {
 			log.Println(err)
 			continue
 		}
+		createAndWriteTranslationLines(note.Translations, noteLevel+1, &lineCounter, buf)
+		createAndWriteRecordIdentificationLines(recordIdentification{note.UserReferences, note.AutomatedRecordId, note.UniqueIds, note.ChangeDate, note.ExternalIds}, noteLevel+1, &lineCounter, buf)
 	}
 
 	repositoryLevel := rootLevel
-	for _, repository := range g.Repositories {
+	for _, repository := range gedcom.Repositories {
 		err := createAndWriteLine(repositoryLevel, repository.Id, "REPO", "", &lineCounter, buf)
 		if err != nil {
 			log.Println(err)
@@ -240,19 +372,39 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 				log.Println(err)
 			}
 		}
+		createAndWriteAddressLines(repository.Address, repositoryLevel+1, &lineCounter, buf)
+		createAndWriteNoteLinkLines(repository.Notes, repositoryLevel+1, &lineCounter, buf)
+		createAndWriteRecordIdentificationLines(recordIdentification{repository.UserReferences, repository.AutomatedRecordId, repository.UniqueIds, repository.ChangeDate, repository.ExternalIds}, repositoryLevel+1, &lineCounter, buf)
 	}
 
 	sourceLevel := rootLevel
-	for _, source := range g.Sources {
+	for _, source := range gedcom.Sources {
 		err := createAndWriteLine(sourceLevel, source.Id, "SOUR", "", &lineCounter, buf)
 		if err != nil {
 			log.Println(err)
 			continue
 		}
+		for _, text := range []tagValue{
+			{"AUTH", source.Author},
+			{"TITL", source.Title},
+			{"PUBL", source.Publication},
+		} {
+			if text.value == "" {
+				continue
+			}
+			err := createAndWriteTextLines(sourceLevel+1, "", text.tag, text.value, &lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+		createAndWriteRepositoryCitationLines(source.RepositoryCitations, sourceLevel+1, &lineCounter, buf)
+		createAndWriteNoteLinkLines(source.Notes, sourceLevel+1, &lineCounter, buf)
+		createAndWriteMultimediaLinkLines(source.MultimediaLinks, sourceLevel+1, &lineCounter, buf)
+		createAndWriteRecordIdentificationLines(recordIdentification{source.UserReferences, source.AutomatedRecordId, source.UniqueIds, source.ChangeDate, source.ExternalIds}, sourceLevel+1, &lineCounter, buf)
 	}
 
 	submitterLevel := rootLevel
-	for _, submitter := range g.Submitters {
+	for _, submitter := range gedcom.Submitters {
 		err := createAndWriteLine(submitterLevel, submitter.Id, "SUBM", "", &lineCounter, buf)
 		if err != nil {
 			log.Println(err)
@@ -266,6 +418,35 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 				log.Println(err)
 			}
 		}
+		createAndWriteAddressLines(submitter.Address, submitterLevel+1, &lineCounter, buf)
+		createAndWriteMultimediaLinkLines(submitter.MultimediaLinks, submitterLevel+1, &lineCounter, buf)
+		createAndWriteValueLines(submitterLevel+1, "LANG", submitter.Languages, &lineCounter, buf)
+		if submitter.RegisteredReferenceNumber != "" {
+			err := createAndWriteLine(submitterLevel+1, "", "RFN", submitter.RegisteredReferenceNumber, &lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+		createAndWriteRecordIdentificationLines(recordIdentification{submitter.UserReferences, submitter.AutomatedRecordId, submitter.UniqueIds, submitter.ChangeDate, submitter.ExternalIds}, submitterLevel+1, &lineCounter, buf)
+	}
+
+	if submission := gedcom.Submission; submission != nil {
+		submissionLevel := rootLevel
+		err := createAndWriteLine(submissionLevel, submission.Id, "SUBN", "", &lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else {
+			createAndWriteTagValueLines(submissionLevel+1, []tagValue{
+				{"SUBM", submission.SubmitterId},
+				{"FAMF", submission.FamilyFileName},
+				{"TEMP", submission.TempleCode},
+				{"ANCE", submission.GenerationsOfAncestors},
+				{"DESC", submission.GenerationsOfDescendants},
+				{"ORDI", submission.OrdinanceProcessFlag},
+				{"RIN", submission.AutomatedRecordId},
+			}, &lineCounter, buf)
+			createAndWriteChangeDateLines(submission.ChangeDate, submissionLevel+1, &lineCounter, buf)
+		}
 	}
 
 	err = createAndWriteLine(rootLevel, "", "TRLR", "", &lineCounter, buf)
@@ -273,29 +454,28 @@ func (g *ConcurrencySafeGedcom) ToSerializedGedcom() (*bytes.Buffer, error) {
 		log.Println(err)
 	}
 
+	if options != nil && options.Version == GedcomVersion7 {
+		return toGedcom7(buf)
+	}
 	return buf, nil
 }
 
 func createAndWriteDeepEventLines(event *Gedcom_Individual_Event, eventLevel int, lineCounter *int, buf *bytes.Buffer) {
-	var dateValue string
-	if event.Date.Year != "" && event.Date.Month != "" && event.Date.Day != "" {
-		dateValue = fmt.Sprintf("%s %s %s", event.Date.Day, util.MonthAbbrByInt[event.Date.Month], event.Date.Year)
-	} else if event.Date.Year != "" && event.Date.Month != "" {
-		dateValue = fmt.Sprintf("%s %s", util.MonthAbbrByInt[event.Date.Month], event.Date.Year)
-	} else if event.Date.Year != "" {
-		dateValue = fmt.Sprintf("%s", event.Date.Year)
-	}
-	if dateValue != "" {
-		dateLevel := eventLevel + 1
-		err := createAndWriteLine(dateLevel, "", "DATE", dateValue, lineCounter, buf)
+	createAndWriteDatePhraseLines("DATE", event.Date, eventLevel+1, lineCounter, buf)
+	createAndWriteDatePhraseLines("_SDATE", event.SortDate, eventLevel+1, lineCounter, buf)
+
+	if event.Place != "" {
+		placeLevel := eventLevel + 1
+		err := createAndWriteLine(placeLevel, "", "PLAC", event.Place, lineCounter, buf)
 		if err != nil {
 			log.Println(err)
 		}
 	}
 
-	if event.Place != "" {
-		placeLevel := eventLevel + 1
-		err := createAndWriteLine(placeLevel, "", "PLAC", event.Place, lineCounter, buf)
+	createAndWriteAddressLines(event.Address, eventLevel+1, lineCounter, buf)
+
+	if event.Restriction != "" {
+		err := createAndWriteLine(eventLevel+1, "", "RESN", event.Restriction, lineCounter, buf)
 		if err != nil {
 			log.Println(err)
 		}
@@ -308,5 +488,194 @@ func createAndWriteDeepEventLines(event *Gedcom_Individual_Event, eventLevel int
 			log.Println(err)
 		}
 	}
+	createAndWriteStructureLinkLines(structureLinks{event.SourceCitations, event.Notes, event.MultimediaLinks}, eventLevel+1, lineCounter, buf)
+}
+
+// toDateValue formats a date as the value of a DATE line, returning an empty string for an empty date
+func toDateValue(date *Gedcom_Individual_Date) string {
+	year, month, day := date.GetYear(), date.GetMonth(), date.GetDay()
+	if year != "" && month != "" && day != "" {
+		return fmt.Sprintf("%s %s %s", day, util.MonthAbbrByInt[month], year)
+	} else if year != "" && month != "" {
+		return fmt.Sprintf("%s %s", util.MonthAbbrByInt[month], year)
+	}
+	return year
+}
 
+// createAndWriteDatePhraseLines writes a date along with its phrase, if either is known
+func createAndWriteDatePhraseLines(tag string, date *Gedcom_Individual_Date, dateLevel int, lineCounter *int, buf *bytes.Buffer) {
+	dateValue := toDateValue(date)
+	if dateValue == "" && date.GetPhrase() == "" {
+		return
+	}
+	err := createAndWriteLine(dateLevel, "", tag, dateValue, lineCounter, buf)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	createAndWriteTagValueLines(dateLevel+1, []tagValue{
+		{"_PHRASE", date.GetPhrase()},
+	}, lineCounter, buf)
+}
+
+func createAndWriteHeaderLines(header *Gedcom_HeaderType, headerLevel int, lineCounter *int, buf *bytes.Buffer) {
+	if header.GetSource() != "" {
+		err := createAndWriteLine(headerLevel, "", "SOUR", header.Source, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else if header.SourceSystem != nil {
+			createAndWriteHeaderSourceLines(header.SourceSystem, headerLevel+1, lineCounter, buf)
+		}
+	}
+	if header.GetDestination() != "" {
+		err := createAndWriteLine(headerLevel, "", "DEST", header.Destination, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if dateValue := toDateValue(header.GetTransmissionDate()); dateValue != "" {
+		err := createAndWriteLine(headerLevel, "", "DATE", dateValue, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else if header.TransmissionTime != "" {
+			err := createAndWriteLine(headerLevel+1, "", "TIME", header.TransmissionTime, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	if header.GetSubmitter() != "" {
+		err := createAndWriteLine(headerLevel, "", "SUBM", header.Submitter, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if header.GetSubmission() != "" {
+		err := createAndWriteLine(headerLevel, "", "SUBN", header.Submission, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if header.GetFileName() != "" {
+		err := createAndWriteLine(headerLevel, "", "FILE", header.FileName, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if header.GetCopyright() != "" {
+		err := createAndWriteTextLines(headerLevel, "", "COPR", header.Copyright, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if header.GetGedcomMetaData() != nil {
+		gedcomMetaDataLevel := headerLevel
+		versionNumber, gedcomForm := header.GedcomMetaData.VersionNumber, header.GedcomMetaData.GedcomForm
+		if isGedcom7(header) {
+			// gedcoms read from GEDCOM 7.0 files are written as GEDCOM 5.5.1 unless serialized to GEDCOM 7.0
+			versionNumber, gedcomForm = "5.5.1", "LINEAGE-LINKED"
+		}
+		err := createAndWriteLine(gedcomMetaDataLevel, "", "GEDC", "", lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else {
+			if versionNumber != "" {
+				err := createAndWriteLine(gedcomMetaDataLevel+1, "", "VERS", versionNumber, lineCounter, buf)
+				if err != nil {
+					log.Println(err)
+				}
+			}
+			if gedcomForm != "" {
+				err := createAndWriteLine(gedcomMetaDataLevel+1, "", "FORM", gedcomForm, lineCounter, buf)
+				if err != nil {
+					log.Println(err)
+				}
+			}
+		}
+	}
+	if header.GetCharacterSet() != "" {
+		err := createAndWriteLine(headerLevel, "", "CHAR", header.CharacterSet, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if header.GetLanguage() != "" {
+		err := createAndWriteLine(headerLevel, "", "LANG", header.Language, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if header.GetPlaceForm() != "" {
+		err := createAndWriteLine(headerLevel, "", "PLAC", "", lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else {
+			err := createAndWriteLine(headerLevel+1, "", "FORM", header.PlaceForm, lineCounter, buf)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	if header.GetNote() != "" {
+		err := createAndWriteTextLines(headerLevel, "", "NOTE", header.Note, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if len(header.GetExtensionTags()) > 0 {
+		err := createAndWriteLine(headerLevel, "", "_SCHMA", "", lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else {
+			for _, extensionTag := range header.ExtensionTags {
+				err := createAndWriteLine(headerLevel+1, "", "TAG", strings.TrimSpace(extensionTag.Tag+" "+extensionTag.Uri), lineCounter, buf)
+				if err != nil {
+					log.Println(err)
+				}
+			}
+		}
+	}
+}
+
+func createAndWriteHeaderSourceLines(sourceSystem *Gedcom_HeaderType_SourceSystemType, sourceLevel int, lineCounter *int, buf *bytes.Buffer) {
+	if sourceSystem.Version != "" {
+		err := createAndWriteLine(sourceLevel, "", "VERS", sourceSystem.Version, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if sourceSystem.ProductName != "" {
+		err := createAndWriteLine(sourceLevel, "", "NAME", sourceSystem.ProductName, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	if sourceSystem.Corporation != nil {
+		err := createAndWriteLine(sourceLevel, "", "CORP", sourceSystem.Corporation.Name, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else {
+			createAndWriteAddressLines(sourceSystem.Corporation.Address, sourceLevel+1, lineCounter, buf)
+		}
+	}
+	if sourceSystem.Data != nil {
+		dataLevel := sourceLevel
+		err := createAndWriteLine(dataLevel, "", "DATA", sourceSystem.Data.Name, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+		} else {
+			if dateValue := toDateValue(sourceSystem.Data.Date); dateValue != "" {
+				err := createAndWriteLine(dataLevel+1, "", "DATE", dateValue, lineCounter, buf)
+				if err != nil {
+					log.Println(err)
+				}
+			}
+			if sourceSystem.Data.Copyright != "" {
+				err := createAndWriteTextLines(dataLevel+1, "", "COPR", sourceSystem.Data.Copyright, lineCounter, buf)
+				if err != nil {
+					log.Println(err)
+				}
+			}
+		}
+	}
 }
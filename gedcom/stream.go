@@ -0,0 +1,141 @@
+package gedcom
+
+import (
+	"google.golang.org/protobuf/proto"
+	"sort"
+)
+
+/*
+A streaming gedcom hands the records it interprets to a record handler instead of holding them, so large files can be
+converted record by record without holding the whole tree in memory. Records are validated before they're handed over,
+against what's known of the whole tree:
+- pointers are checked against the ids of all records, added up front by AddRecordId, so dangling pointers are reported,
+removed or pointed to placeholder records, which are handed over along with the record holding the pointer
+- ancestry cycles are reported from the parents and children of families, which are all the gedcom keeps of them
+- duplicate ids are reported but left as is, as which of the records keeps its id depends on the order of the records,
+which isn't known while records are interpreted concurrently
+Breaking ancestry cycles, linting, applying restrictions and applying living policies change or check records based on
+other records, which may already have been handed over, so they take a gedcom holding the whole tree (see Streamable).
+*/
+
+// RecordHandler handles a record as it's interpreted, given its NDJSON record type, e.g. IndividualRecordType.
+// Records are handed over one at a time, so handlers don't need to be safe for concurrent use.
+type RecordHandler func(recordType string, record proto.Message)
+
+// recordStream holds what a streaming gedcom knows of the whole tree while handing its records over
+type recordStream struct {
+	handler          RecordHandler
+	danglingPointers DanglingPointerPolicy
+	// the tags of the records by their ids, a single id may be used by several records
+	recordTagsByIds map[string][]string
+	placeholderIds  map[string]bool
+	// the issues found in the records handed over
+	diagnostics []*Diagnostic
+}
+
+// Streamable reports whether records can be validated and exported as they're interpreted under the given options,
+// rather than taking a gedcom holding the whole tree
+func Streamable(validateOptions *ValidateOptions, exportOptions *ExportOptions) bool {
+	if validateOptions != nil && (validateOptions.AncestryCycles == AncestryCyclesBreak || validateOptions.Lint != nil) {
+		return false
+	}
+	return exportOptions == nil || !exportOptions.ApplyRestrictions && exportOptions.Living == LivingKeep
+}
+
+// NewStreamingGedcom returns a gedcom handing the records it interprets to a handler, validated under the given options.
+// Only the header, which is handed over as well, and the parents and children of families are kept.
+func NewStreamingGedcom(options *ValidateOptions, handler RecordHandler) *ConcurrencySafeGedcom {
+	if options == nil {
+		options = &ValidateOptions{}
+	}
+	gedcom := NewConcurrencySafeGedcom()
+	gedcom.stream = &recordStream{
+		handler:          handler,
+		danglingPointers: options.DanglingPointers,
+		recordTagsByIds:  map[string][]string{},
+		placeholderIds:   map[string]bool{},
+	}
+	return gedcom
+}
+
+// AddRecordId adds the id of a record to the ids a streaming gedcom checks pointers against, given the first line of
+// the record. The ids of all records are added before any record is interpreted, as records point to records further on.
+func (g *ConcurrencySafeGedcom) AddRecordId(recordLine *Line) {
+	id := recordLine.XRefID()
+	tag, err := recordLine.Tag()
+	if id == "" || err != nil {
+		return
+	}
+	if tag == "SNOTE" {
+		tag = noteRecordTag
+	}
+	if !hasRecordTag(recordTags, tag) {
+		return
+	}
+	g.lock()
+	defer g.unlock()
+	g.stream.recordTagsByIds[id] = append(g.stream.recordTagsByIds[id], tag)
+}
+
+// handleRecord validates a record and hands it to the record handler of a streaming gedcom, along with the placeholder
+// records created for it, reporting whether it was handed over. Callers hold the lock, so records are handed over one at a time.
+func (g *ConcurrencySafeGedcom) handleRecord(record proto.Message) bool {
+	if g.stream == nil {
+		return false
+	}
+	gedcom := &Gedcom{}
+	addRecord(gedcom, record)
+	g.stream.diagnostics = append(g.stream.diagnostics,
+		validatePointers(gedcom, g.stream.recordTagsByIds, g.stream.placeholderIds, g.stream.danglingPointers)...)
+	g.stream.diagnostics = append(g.stream.diagnostics, validateRecordLdsOrdinances(gedcom)...)
+	for _, f := range gedcom.Families {
+		g.Families = append(g.Families, &Gedcom_Family{Id: f.Id, FatherId: f.FatherId, MotherId: f.MotherId, ChildIds: f.ChildIds})
+	}
+	forEachRecord(gedcom, g.stream.handler)
+	return true
+}
+
+// validateStream returns the issues found in the records a streaming gedcom handed over, along with the duplicate ids
+// and the ancestry cycles of the gedcom
+func (g *ConcurrencySafeGedcom) validateStream() []*Diagnostic {
+	var diagnostics []*Diagnostic
+	diagnostics = append(diagnostics, g.readDiagnostics...)
+	var ids []string
+	for id := range g.stream.recordTagsByIds {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		for _, recordTag := range g.stream.recordTagsByIds[id][1:] {
+			diagnostics = append(diagnostics, newDiagnostic("duplicate-xref", SeverityWarning, id,
+				"duplicate xRefId on %s record, left as is as records are streamed", recordTag))
+		}
+	}
+	diagnostics = append(diagnostics, g.stream.diagnostics...)
+	diagnostics = append(diagnostics, g.ValidateAncestryCycles(AncestryCyclesReport)...)
+	return diagnostics
+}
+
+// addRecord adds a record to a gedcom
+func addRecord(gedcom *Gedcom, record proto.Message) {
+	switch r := record.(type) {
+	case *Gedcom_HeaderType:
+		gedcom.Header = r
+	case *Gedcom_Individual:
+		gedcom.Individuals = append(gedcom.Individuals, r)
+	case *Gedcom_Family:
+		gedcom.Families = append(gedcom.Families, r)
+	case *Gedcom_Multimedia:
+		gedcom.Multimedias = append(gedcom.Multimedias, r)
+	case *Gedcom_Note:
+		gedcom.Notes = append(gedcom.Notes, r)
+	case *Gedcom_Repository:
+		gedcom.Repositories = append(gedcom.Repositories, r)
+	case *Gedcom_Source:
+		gedcom.Sources = append(gedcom.Sources, r)
+	case *Gedcom_Submitter:
+		gedcom.Submitters = append(gedcom.Submitters, r)
+	case *Gedcom_SubmissionType:
+		gedcom.Submission = r
+	}
+}
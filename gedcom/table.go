@@ -0,0 +1,152 @@
+package gedcom
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Table is a table of a tabular export of a gedcom, with one row per entity and records referred to by their xRefIds
+type Table struct {
+	Name    string
+	Columns []string
+	Rows    [][]string
+}
+
+// names of the tables of a tabular export, in the order ToTables returns them
+const (
+	IndividualsTable   = "individuals"
+	NamesTable         = "names"
+	EventsTable        = "events"
+	FamiliesTable      = "families"
+	FamilyMembersTable = "family_members"
+)
+
+// ParseCSVDelimiter parses the delimiter of CSV files, which is a single character or tab.
+// An empty value leaves the delimiter unset (0), so it defaults to a comma, or to a tab for tab-separated files.
+func ParseCSVDelimiter(value string) (rune, error) {
+	switch value {
+	case "":
+		return 0, nil
+	case ",":
+		return ',', nil
+	case "tab", `\t`, "\t":
+		return '\t', nil
+	}
+	delimiter, size := utf8.DecodeRuneInString(value)
+	if size != len(value) || delimiter == '"' || delimiter == '\r' || delimiter == '\n' || delimiter == utf8.RuneError {
+		return ',', fmt.Errorf("invalid CSV delimiter %s, expected a single character or tab", value)
+	}
+	return delimiter, nil
+}
+
+// ToTables exports a gedcom as tables of individuals, their names and events, families and their members
+func (g *ConcurrencySafeGedcom) ToTables(options *ExportOptions) []*Table {
+	gedcom := g.exportedGedcom(options)
+
+	individuals := &Table{Name: IndividualsTable, Columns: []string{"id", "gender", "given_name", "surname", "restriction", "automated_record_id", "change_date"}}
+	names := &Table{Name: NamesTable, Columns: []string{"individual_id", "position", "given_name", "surname", "primary"}}
+	events := &Table{Name: EventsTable, Columns: []string{"individual_id", "family_id", "type", "date", "sort_date", "place", "primary", "restriction"}}
+	families := &Table{Name: FamiliesTable, Columns: []string{"id", "father_id", "mother_id", "restriction", "automated_record_id", "change_date"}}
+	familyMembers := &Table{Name: FamilyMembersTable, Columns: []string{"family_id", "individual_id", "role", "pedigree"}}
+
+	pedigreesByFamilyAndChildIds := map[[2]string]string{}
+	for _, i := range gedcom.Individuals {
+		var name *Gedcom_Individual_Name
+		if len(i.Names) > 0 {
+			name = i.Names[0]
+		}
+		individuals.Rows = append(individuals.Rows, []string{
+			i.Id, i.Gender, name.GetGivenName(), name.GetSurname(), i.Restriction, i.AutomatedRecordId, changeDateValue(i.ChangeDate),
+		})
+		for position, n := range i.Names {
+			names.Rows = append(names.Rows, []string{
+				i.Id, strconv.Itoa(position + 1), n.GivenName, n.Surname, strconv.FormatBool(n.Primary),
+			})
+		}
+		for _, eventType := range IndividualEventTypes {
+			for _, event := range *eventType.Events(i) {
+				events.Rows = append(events.Rows, eventRow(i.Id, "", eventType.Tag, event))
+			}
+		}
+		for _, link := range i.ChildToFamilyLinks {
+			pedigreesByFamilyAndChildIds[[2]string{link.FamilyId, i.Id}] = link.Pedigree
+		}
+	}
+
+	for _, f := range gedcom.Families {
+		families.Rows = append(families.Rows, []string{
+			f.Id, f.FatherId, f.MotherId, f.Restriction, f.AutomatedRecordId, changeDateValue(f.ChangeDate),
+		})
+		for _, event := range f.MarriageEvents {
+			events.Rows = append(events.Rows, eventRow("", f.Id, MarriageEventTag, event))
+		}
+		if f.FatherId != "" {
+			familyMembers.Rows = append(familyMembers.Rows, []string{f.Id, f.FatherId, "father", ""})
+		}
+		if f.MotherId != "" {
+			familyMembers.Rows = append(familyMembers.Rows, []string{f.Id, f.MotherId, "mother", ""})
+		}
+		for _, childId := range f.ChildIds {
+			familyMembers.Rows = append(familyMembers.Rows, []string{f.Id, childId, "child", pedigreesByFamilyAndChildIds[[2]string{f.Id, childId}]})
+		}
+	}
+
+	return []*Table{individuals, names, events, families, familyMembers}
+}
+
+func eventRow(individualId string, familyId string, tag string, event *Gedcom_Individual_Event) []string {
+	return []string{
+		individualId, familyId, tag, DateValue(event.Date), DateValue(event.SortDate), event.Place, strconv.FormatBool(event.Primary), event.Restriction,
+	}
+}
+
+// DateValue formats a date as the value of a DATE line, or its phrase if it has no value
+func DateValue(date *Gedcom_Individual_Date) string {
+	if value := toDateValue(date); value != "" {
+		return value
+	}
+	return date.GetPhrase()
+}
+
+// changeDateValue formats a change date as its date followed by its time, if known
+func changeDateValue(changeDate *Gedcom_ChangeDate) string {
+	return strings.TrimSpace(toDateValue(changeDate.GetDate()) + " " + changeDate.GetTime())
+}
+
+// WriteCSV writes a table as CSV with a header row of its column names
+func (t *Table) WriteCSV(buf *bytes.Buffer, delimiter rune) error {
+	writer := csv.NewWriter(buf)
+	writer.Comma = delimiter
+	err := writer.Write(t.Columns)
+	if err != nil {
+		return err
+	}
+	err = writer.WriteAll(t.Rows)
+	if err != nil {
+		return fmt.Errorf("failed to write %s table with error: %s", t.Name, err)
+	}
+	return nil
+}
+
+// ToCSV exports a gedcom as CSV files by the names of their tables, delimited by the CSV delimiter of the export options
+func (g *ConcurrencySafeGedcom) ToCSV(options *ExportOptions) (map[string]*[]byte, error) {
+	delimiter := ','
+	if options != nil && options.CSVDelimiter != 0 {
+		delimiter = options.CSVDelimiter
+	}
+	csvFiles := map[string]*[]byte{}
+	for _, table := range g.ToTables(options) {
+		buf := bytes.NewBuffer([]byte{})
+		err := table.WriteCSV(buf, delimiter)
+		if err != nil {
+			return nil, err
+		}
+		tableBytes := buf.Bytes()
+		csvFiles[table.Name] = &tableBytes
+	}
+	return csvFiles, nil
+}
@@ -0,0 +1,146 @@
+package gedcom
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestToTables(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"0 @I1@ INDI",
+		"1 NAME John /Smith/",
+		"1 NAME Johnny",
+		"1 SEX M",
+		"1 BIRT",
+		"2 DATE 1 JAN 1900",
+		"2 PLAC London, England",
+		"0 @I2@ INDI",
+		"1 NAME Jim /Smith/",
+		"1 FAMC @F1@",
+		"2 PEDI adopted",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"1 CHIL @I2@",
+		"1 MARR",
+		"2 DATE 1925",
+		"0 TRLR",
+	})
+	tables := map[string]*Table{}
+	for _, table := range g.ToTables(nil) {
+		tables[table.Name] = table
+	}
+
+	expectedRows := map[string][][]string{
+		IndividualsTable: {
+			{"@I1@", "MALE", "John", "Smith", "", "", ""},
+			{"@I2@", "", "Jim", "Smith", "", "", ""},
+		},
+		NamesTable: {
+			{"@I1@", "1", "John", "Smith", "false"},
+			{"@I1@", "2", "Johnny", "", "false"},
+			{"@I2@", "1", "Jim", "Smith", "false"},
+		},
+		EventsTable: {
+			{"@I1@", "", "BIRT", "1 JAN 1900", "", "London, England", "false", ""},
+			{"", "@F1@", "MARR", "1925", "", "", "false", ""},
+		},
+		FamiliesTable: {
+			{"@F1@", "@I1@", "", "", "", ""},
+		},
+		FamilyMembersTable: {
+			{"@F1@", "@I1@", "father", ""},
+			{"@F1@", "@I2@", "child", "adopted"},
+		},
+	}
+	for name, expected := range expectedRows {
+		table, ok := tables[name]
+		if !ok {
+			t.Errorf("missing table %s", name)
+			continue
+		}
+		actual := table.Rows
+		if name == IndividualsTable || name == NamesTable || name == EventsTable {
+			actual = sortedRows(actual)
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("expected %s rows %v, found %v", name, expected, actual)
+		}
+		for _, row := range table.Rows {
+			if len(row) != len(table.Columns) {
+				t.Errorf("row %v of %s doesn't match columns %v", row, name, table.Columns)
+			}
+		}
+	}
+}
+
+// sortedRows sorts rows by their first columns, as individuals are interpreted in no particular order
+func sortedRows(rows [][]string) [][]string {
+	sorted := append([][]string{}, rows...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lessRow(sorted[i], sorted[j])
+	})
+	return sorted
+}
+
+func lessRow(a []string, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] == "" || b[i] == "" {
+				return a[i] != ""
+			}
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+func TestToCSV(t *testing.T) {
+	g := NewConcurrencySafeGedcom()
+	g.Individuals = []*Gedcom_Individual{
+		{Id: "@I1@", Names: []*Gedcom_Individual_Name{{GivenName: "John \"Jack\"", Surname: "Smith, Jr."}}},
+	}
+	cases := []struct {
+		delimiter rune
+		expected  string
+	}{
+		{0, "id,gender,given_name,surname,restriction,automated_record_id,change_date\n@I1@,,\"John \"\"Jack\"\"\",\"Smith, Jr.\",,,\n"},
+		{'\t', "id\tgender\tgiven_name\tsurname\trestriction\tautomated_record_id\tchange_date\n@I1@\t\t\"John \"\"Jack\"\"\"\tSmith, Jr.\t\t\t\n"},
+	}
+	for _, c := range cases {
+		csvFiles, err := g.ToCSV(&ExportOptions{CSVDelimiter: c.delimiter})
+		if err != nil {
+			t.Fatalf("failed to export CSV with error: %s", err)
+		}
+		if len(csvFiles) != 5 {
+			t.Errorf("expected 5 CSV files, found %d", len(csvFiles))
+		}
+		if actual := string(*csvFiles[IndividualsTable]); actual != c.expected {
+			t.Errorf("delimiter %q: expected %q, found %q", c.delimiter, c.expected, actual)
+		}
+	}
+}
+
+func TestParseCSVDelimiter(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected rune
+		valid    bool
+	}{
+		{"", 0, true},
+		{",", ',', true},
+		{";", ';', true},
+		{"tab", '\t', true},
+		{`\t`, '\t', true},
+		{"|", '|', true},
+		{";;", ',', false},
+		{`"`, ',', false},
+	}
+	for _, c := range cases {
+		delimiter, err := ParseCSVDelimiter(c.value)
+		if delimiter != c.expected || (err == nil) != c.valid {
+			t.Errorf("ParseCSVDelimiter(%q) = %q, %v", c.value, delimiter, err)
+		}
+	}
+}
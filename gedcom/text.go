@@ -0,0 +1,70 @@
+package gedcom
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+// maximum amount of characters of a line value before it gets split over CONC lines
+const maxLineValueLength = 200
+
+// interpretTextStructure interprets a line value that might continue over subordinate CONT and CONC lines.
+// CONT lines start a new line in the text, CONC lines are concatenated to the preceding text.
+func interpretTextStructure(textLines []*Line) string {
+	var sb strings.Builder
+	sb.WriteString(textLines[0].Value())
+	forEachSubordinateLine(textLines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "CONT":
+			sb.WriteString("\n")
+			sb.WriteString(subordinateLines[0].Value())
+		case "CONC":
+			sb.WriteString(subordinateLines[0].Value())
+		}
+	})
+	return sb.String()
+}
+
+// createAndWriteTextLines writes text as a line value,
+// spreading it over subordinate CONT lines for newlines and CONC lines for long values.
+func createAndWriteTextLines(level int, xRefID string, tag string, text string, lineCounter *int, buf *bytes.Buffer) error {
+	for i, textLine := range strings.Split(text, "\n") {
+		lineTag, lineLevel := tag, level
+		if i > 0 {
+			lineTag, lineLevel = "CONT", level+1
+		}
+		lineXRefID := ""
+		if i == 0 {
+			lineXRefID = xRefID
+		}
+		for {
+			part := textLine[:concSplitIndex(textLine)]
+			err := createAndWriteLine(lineLevel, lineXRefID, lineTag, part, lineCounter, buf)
+			if err != nil {
+				return err
+			}
+			textLine = textLine[len(part):]
+			if textLine == "" {
+				break
+			}
+			lineTag, lineLevel, lineXRefID = "CONC", level+1, ""
+		}
+	}
+	return nil
+}
+
+// concSplitIndex determines where a line value should be split to continue it on a CONC line.
+// Values are never split inside a multi-byte character or next to a space,
+// as some applications trim leading and trailing spaces of line values.
+func concSplitIndex(value string) int {
+	if len(value) <= maxLineValueLength {
+		return len(value)
+	}
+	for i := maxLineValueLength; i > 0; i-- {
+		if utf8.RuneStart(value[i]) && value[i] != ' ' && value[i-1] != ' ' {
+			return i
+		}
+	}
+	return maxLineValueLength
+}
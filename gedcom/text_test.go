@@ -0,0 +1,39 @@
+package gedcom
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func splitLines(s string) []string {
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+func TestTextStructure(t *testing.T) {
+	texts := []string{
+		"",
+		"short note",
+		"first line\nsecond line\n\nfourth line",
+		strings.Repeat("long word ", 100),
+		strings.Repeat("é", 300),
+	}
+	for _, text := range texts {
+		buf := bytes.NewBuffer([]byte{})
+		lineCounter := 0
+		err := createAndWriteTextLines(1, "@N1@", "NOTE", text, &lineCounter, buf)
+		if err != nil {
+			t.Fatalf("failed to write text %q with error: %s", text, err)
+		}
+		for _, line := range splitLines(buf.String()) {
+			if len(line) > 255 {
+				t.Errorf("written line exceeds 255 characters: %s", line)
+			}
+		}
+
+		result := interpretTextStructure(recordLines(splitLines(buf.String())))
+		if result != text {
+			t.Errorf("result text does not equal expected; result: %q, expected %q", result, text)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package gedcom
+
+import (
+	"bytes"
+	"log"
+)
+
+// interpretTranslations interprets the GEDCOM 7.0 translations (TRAN) of a name or text,
+// along with the language (LANG) and media type (MIME) of every translation.
+// GEDCOM 5.5.1 doesn't know translations, so they're written as user-defined _TRAN structures there.
+func interpretTranslations(lines []*Line) []*Gedcom_Translation {
+	var translations []*Gedcom_Translation
+	forEachSubordinateLine(lines, func(tag string, subordinateLines []*Line) {
+		switch tag {
+		case "TRAN", "_TRAN":
+			translation := &Gedcom_Translation{
+				Text: interpretTextStructure(subordinateLines),
+			}
+			forEachSubordinateLine(subordinateLines, func(tag string, translationLines []*Line) {
+				switch tag {
+				case "LANG":
+					translation.Language = translationLines[0].Value()
+				case "MIME":
+					translation.MediaType = translationLines[0].Value()
+				}
+			})
+			translations = append(translations, translation)
+		}
+	})
+	return translations
+}
+
+func createAndWriteTranslationLines(translations []*Gedcom_Translation, translationLevel int, lineCounter *int, buf *bytes.Buffer) {
+	for _, translation := range translations {
+		err := createAndWriteTextLines(translationLevel, "", "_TRAN", translation.Text, lineCounter, buf)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		createAndWriteTagValueLines(translationLevel+1, []tagValue{
+			{"MIME", translation.MediaType},
+			{"LANG", translation.Language},
+		}, lineCounter, buf)
+	}
+}
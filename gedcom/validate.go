@@ -2,75 +2,164 @@ package gedcom
 
 import (
 	"fmt"
-	"log"
+	"github.com/jochenboesmans/gedcom-parser/util"
+	"strings"
 )
 
-func (g *ConcurrencySafeGedcom) ValidateIdUniqueness() {
-	alternativeId := 999999 // TODO: assign definitely unique alternative ids
-	ids := map[string]bool{}
-	for i, indi := range g.Individuals {
-		if alreadyInMap := ids[indi.Id]; alreadyInMap {
-			g.lock()
-			g.Individuals[i].Id = fmt.Sprintf("@I%d@", alternativeId)
-			g.unlock()
-			alternativeId--
-		}
-	}
+// replacement id prefixes by the tag of the records they identify
+var xRefIdPrefixes = map[string]string{
+	individualRecordTag: "I",
+	familyRecordTag:     "F",
+	multimediaRecordTag: "M",
+	noteRecordTag:       "N",
+	repositoryRecordTag: "R",
+	sourceRecordTag:     "S",
+	submitterRecordTag:  "U",
+	submissionRecordTag: "SUBN",
 }
 
-func contains(submitters []*Gedcom_Submitter, xRefId string) bool {
-	for _, s := range submitters {
-		if s.Id == xRefId {
-			return true
+// ValidateIdUniqueness ensures every record has an xRefId that isn't shared with any other record, of any type.
+// The first record carrying a duplicated id keeps it, every other one gets a replacement id that isn't used anywhere.
+// Pointers are typed by their context, so when the duplicates are records of different types, the pointers to
+// the renamed record's type follow it to its replacement id. Pointers to records of the same type can't be told
+// apart and keep referring to the first record of that type.
+func (g *ConcurrencySafeGedcom) ValidateIdUniqueness() []*Diagnostic {
+	g.lock()
+	defer g.unlock()
+
+	usedIds := map[string]bool{}
+	idCounts := map[string]int{}
+	forEachRecordId(&g.Gedcom, func(_ string, id *string) {
+		usedIds[*id] = true
+		idCounts[*id]++
+	})
+
+	counters := map[string]int{}
+	replacementId := func(recordTag string) string {
+		for {
+			counters[recordTag]++
+			id := fmt.Sprintf("@%s%d@", xRefIdPrefixes[recordTag], counters[recordTag])
+			if !usedIds[id] {
+				usedIds[id] = true
+				return id
+			}
 		}
 	}
-	return false
-}
 
-func (g *ConcurrencySafeGedcom) ValidateHeaderXRefIntegrity() {
-	submitterXRefId := g.Header.Submitter
-	if submitterXRefId == "" {
-		return
-	}
-	if !contains(g.Submitters, submitterXRefId) {
-		if len(g.Submitters) > 0 {
-			alternative := g.Submitters[0].Id
-			log.Printf("invalid submitter xRefId in header (%s), defaulting to %s", submitterXRefId, alternative)
+	var diagnostics []*Diagnostic
+	keptIds := map[string]bool{}
+	// pointer rewrites by tag of the record pointed to, by original id
+	rewrites := map[string]map[string]string{}
+	// ids already claimed by a record of a given type, by tag of the record
+	claimedIds := map[string]map[string]bool{}
+	forEachRecordId(&g.Gedcom, func(recordTag string, id *string) {
+		if idCounts[*id] < 2 {
+			return
+		}
+		originalId := *id
+		firstOfType := !claimedIds[recordTag][originalId]
+		if claimedIds[recordTag] == nil {
+			claimedIds[recordTag] = map[string]bool{}
+		}
+		claimedIds[recordTag][originalId] = true
+		if !keptIds[originalId] {
+			keptIds[originalId] = true
+			return
+		}
+
+		*id = replacementId(recordTag)
+		if firstOfType {
+			if rewrites[recordTag] == nil {
+				rewrites[recordTag] = map[string]string{}
+			}
+			rewrites[recordTag][originalId] = *id
+			diagnostics = append(diagnostics, newDiagnostic("duplicate-xref", SeverityWarning, originalId,
+				"duplicate xRefId on %s record replaced with %s, pointers to %s records follow", recordTag, *id, recordTag))
 		} else {
-			log.Printf("invalid submitter xRefId in header (%s), no alternative found, removing submitter xRefId from header", submitterXRefId)
+			diagnostics = append(diagnostics, newDiagnostic("duplicate-xref", SeverityWarning, originalId,
+				"duplicate xRefId on %s record replaced with %s, pointers keep referring to the first %s record", recordTag, *id, recordTag))
 		}
-	}
+	})
 
+	forEachPointer(&g.Gedcom, func(recordTag string, pointer *string, _ string) {
+		if replacement, ok := rewrites[recordTag][*pointer]; ok {
+			*pointer = replacement
+		}
+	})
+
+	return diagnostics
 }
 
-// ValidateFamilyRecordXRefIdIntegrity ensures integrity of cross references to indi records in family records
-// COST WARNING: O(f*c) where f is the amount of family records and c is the amount of children in a family
-func (g *ConcurrencySafeGedcom) ValidateFamilyRecordXRefIdIntegrity() {
-	indexedIndividuals := g.IndividualsByIds()
+// ValidateLdsOrdinances checks the temple codes and status values of all LDS ordinances
+// against the enumerations of GEDCOM 5.5.1 and reports every invalid value.
+func (g *ConcurrencySafeGedcom) ValidateLdsOrdinances() []*Diagnostic {
+	return validateRecordLdsOrdinances(&g.Gedcom)
+}
 
-	for i, f := range g.Families {
-		if _, ok := indexedIndividuals[f.MotherId]; !ok {
-			g.lock()
-			g.Families[i].MotherId = ""
-			g.unlock()
-		}
-		if _, ok := indexedIndividuals[f.FatherId]; !ok {
-			g.lock()
-			g.Families[i].FatherId = ""
-			g.unlock()
-		}
-		for j, childId := range f.ChildIds {
-			if _, ok := indexedIndividuals[childId]; !ok {
-				g.lock()
-				g.Families[i].ChildIds[j] = ""
-				g.unlock()
-			}
+// validateRecordLdsOrdinances validates the LDS ordinances of the individuals and families of a gedcom
+func validateRecordLdsOrdinances(g *Gedcom) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	for _, indi := range g.Individuals {
+		diagnostics = append(diagnostics, validateLdsOrdinances(indi.Id, "BAPL", indi.LdsBaptisms)...)
+		diagnostics = append(diagnostics, validateLdsOrdinances(indi.Id, "CONL", indi.LdsConfirmations)...)
+		diagnostics = append(diagnostics, validateLdsOrdinances(indi.Id, "ENDL", indi.LdsEndowments)...)
+		diagnostics = append(diagnostics, validateLdsOrdinances(indi.Id, "SLGC", indi.LdsChildSealings)...)
+	}
+	for _, f := range g.Families {
+		diagnostics = append(diagnostics, validateLdsOrdinances(f.Id, "SLGS", f.LdsSpouseSealings)...)
+	}
+	return diagnostics
+}
+
+func validateLdsOrdinances(xRefId string, tag string, ordinances []*Gedcom_LdsOrdinance) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	for _, ordinance := range ordinances {
+		for _, err := range ldsOrdinanceErrors(tag, ordinance) {
+			diagnostics = append(diagnostics, newDiagnostic("lds-ordinance", SeverityWarning, xRefId,
+				"invalid %s ordinance: %s", tag, err))
 		}
 	}
+	return diagnostics
+}
+
+func ldsOrdinanceErrors(tag string, ordinance *Gedcom_LdsOrdinance) []error {
+	var errs []error
+	if ordinance.TempleCode != "" && !util.LDSTempleCodes[strings.ToUpper(ordinance.TempleCode)] {
+		errs = append(errs, fmt.Errorf("unknown temple code: %s", ordinance.TempleCode))
+	}
+	if ordinance.Status != "" && !util.LDSStatusesByOrdinance[tag][strings.ToUpper(ordinance.Status)] {
+		errs = append(errs, fmt.Errorf("invalid status: %s", ordinance.Status))
+	}
+	return errs
+}
+
+// ValidateOptions configure how validation repairs the issues it finds
+type ValidateOptions struct {
+	DanglingPointers DanglingPointerPolicy
+	AncestryCycles   AncestryCyclePolicy
+	// Lint enables the plausibility checks of Lint after the gedcom has been repaired, nil options don't lint
+	Lint *LintOptions
 }
 
-func (g *ConcurrencySafeGedcom) Validate() {
-	g.ValidateIdUniqueness()
-	g.ValidateHeaderXRefIntegrity()
-	g.ValidateFamilyRecordXRefIdIntegrity()
+// Validate runs all validations, repairing what can be repaired, and returns a diagnostic for every issue found,
+// along with the issues found while reading the gedcom. Streaming gedcoms validate their records as they're handed over,
+// under the options they were created with, so for them Validate only reports the issues found (see NewStreamingGedcom).
+func (g *ConcurrencySafeGedcom) Validate(options *ValidateOptions) []*Diagnostic {
+	if g.stream != nil {
+		return g.validateStream()
+	}
+	if options == nil {
+		options = &ValidateOptions{}
+	}
+
+	var diagnostics []*Diagnostic
+	diagnostics = append(diagnostics, g.readDiagnostics...)
+	diagnostics = append(diagnostics, g.ValidateIdUniqueness()...)
+	diagnostics = append(diagnostics, g.ValidatePointerIntegrity(options.DanglingPointers)...)
+	diagnostics = append(diagnostics, g.ValidateAncestryCycles(options.AncestryCycles)...)
+	diagnostics = append(diagnostics, g.ValidateLdsOrdinances()...)
+	if options.Lint != nil {
+		diagnostics = append(diagnostics, g.Lint(options.Lint)...)
+	}
+	return diagnostics
 }
@@ -0,0 +1,158 @@
+package gedcom
+
+import (
+	"strings"
+	"testing"
+)
+
+func danglingPointerTestGedcom() *ConcurrencySafeGedcom {
+	g := NewConcurrencySafeGedcom()
+	g.Header = &Gedcom_HeaderType{Submitter: "@U404@"}
+	g.Individuals = []*Gedcom_Individual{
+		{
+			Id:      "@I1@",
+			Aliases: []string{"@I2@", "@I404@", "Harry"},
+			Associations: []*Gedcom_Individual_Association{
+				{IndividualId: "@I2@", Relation: "Godfather"},
+				{IndividualId: "@I404@", Relation: "Witness"},
+			},
+			ChildToFamilyLinks: []*Gedcom_Individual_FamilyLink{{FamilyId: "@F1@"}, {FamilyId: "@F404@"}},
+		},
+		{Id: "@I2@"},
+	}
+	g.Families = []*Gedcom_Family{
+		{Id: "@F1@", FatherId: "@I2@", MotherId: "@I404@", ChildIds: []string{"@I1@", "@I405@"}},
+	}
+	g.Sources = []*Gedcom_Source{
+		{Id: "@S1@", RepositoryCitations: []*Gedcom_RepositoryCitation{{RepositoryId: "@R404@"}}},
+	}
+	return g
+}
+
+func TestValidatePointerIntegrity(t *testing.T) {
+	cases := []struct {
+		policy              DanglingPointerPolicy
+		expectedIndividuals int
+		expectedAliases     int
+		expectedChildIds    []string
+		expectedMotherId    string
+		expectedSubmitter   string
+	}{
+		{DanglingPointersReport, 2, 3, []string{"@I1@", "@I405@"}, "@I404@", "@U404@"},
+		{DanglingPointersRemove, 2, 2, []string{"@I1@"}, "", ""},
+		{DanglingPointersPlaceholder, 4, 3, []string{"@I1@", "@I405@"}, "@I404@", "@U404@"},
+	}
+
+	for _, c := range cases {
+		g := danglingPointerTestGedcom()
+		diagnostics := g.ValidatePointerIntegrity(c.policy)
+
+		if len(diagnostics) != 7 {
+			t.Errorf("policy %q: expected 7 diagnostics, got %v", c.policy, diagnostics)
+		}
+		for _, d := range diagnostics {
+			if d.Rule != "dangling-pointer" {
+				t.Errorf("policy %q: unexpected diagnostic %v", c.policy, d)
+			}
+		}
+		if len(g.Individuals) != c.expectedIndividuals {
+			t.Errorf("policy %q: expected %d individuals, got %d", c.policy, c.expectedIndividuals, len(g.Individuals))
+		}
+		if len(g.Individuals[0].Aliases) != c.expectedAliases {
+			t.Errorf("policy %q: expected %d aliases, got %v", c.policy, c.expectedAliases, g.Individuals[0].Aliases)
+		}
+		f := g.Families[0]
+		if strings.Join(f.ChildIds, ",") != strings.Join(c.expectedChildIds, ",") {
+			t.Errorf("policy %q: expected child ids %v, got %v", c.policy, c.expectedChildIds, f.ChildIds)
+		}
+		if f.MotherId != c.expectedMotherId {
+			t.Errorf("policy %q: expected mother id %q, got %q", c.policy, c.expectedMotherId, f.MotherId)
+		}
+		if g.Header.Submitter != c.expectedSubmitter {
+			t.Errorf("policy %q: expected header submitter %q, got %q", c.policy, c.expectedSubmitter, g.Header.Submitter)
+		}
+	}
+
+	g := danglingPointerTestGedcom()
+	g.ValidatePointerIntegrity(DanglingPointersRemove)
+	i := g.Individuals[0]
+	if len(i.Associations) != 1 || i.Associations[0].IndividualId != "@I2@" {
+		t.Errorf("expected dangling association to be removed, got %+v", i.Associations)
+	}
+	if len(i.ChildToFamilyLinks) != 1 || i.ChildToFamilyLinks[0].FamilyId != "@F1@" {
+		t.Errorf("expected dangling family link to be removed, got %+v", i.ChildToFamilyLinks)
+	}
+	if len(g.Sources[0].RepositoryCitations) != 0 {
+		t.Errorf("expected dangling repository citation to be removed, got %+v", g.Sources[0].RepositoryCitations)
+	}
+
+	g = danglingPointerTestGedcom()
+	g.ValidatePointerIntegrity(DanglingPointersPlaceholder)
+	if len(g.Families) != 2 || len(g.Repositories) != 1 || len(g.Submitters) != 1 || g.Submitters[0].Id != "@U404@" {
+		t.Errorf("expected placeholder family, repository and submitter, got %+v, %+v and %+v", g.Families, g.Repositories, g.Submitters)
+	}
+}
+
+func TestValidateIdUniqueness(t *testing.T) {
+	g := NewConcurrencySafeGedcom()
+	g.Header = &Gedcom_HeaderType{Submitter: "@X1@"}
+	g.Individuals = []*Gedcom_Individual{
+		{Id: "@I1@"},
+		{Id: "@I1@"},
+		{Id: "@X1@"},
+	}
+	g.Families = []*Gedcom_Family{
+		{Id: "@F1@", FatherId: "@I1@", MotherId: "@X1@"},
+	}
+	g.Submitters = []*Gedcom_Submitter{{Id: "@X1@"}}
+	g.Notes = []*Gedcom_Note{{Id: "@I2@"}}
+
+	diagnostics := g.ValidateIdUniqueness()
+
+	if len(diagnostics) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diagnostics)
+	}
+	if g.Individuals[0].Id != "@I1@" || g.Individuals[2].Id != "@X1@" {
+		t.Errorf("expected first records to keep their ids, got %s and %s", g.Individuals[0].Id, g.Individuals[2].Id)
+	}
+	if g.Individuals[1].Id != "@I3@" {
+		t.Errorf("expected replacement id to skip ids in use, got %s", g.Individuals[1].Id)
+	}
+	if g.Submitters[0].Id != "@U1@" {
+		t.Errorf("expected submitter to get replacement id @U1@, got %s", g.Submitters[0].Id)
+	}
+	if g.Header.Submitter != "@U1@" {
+		t.Errorf("expected header submitter pointer to follow the submitter, got %s", g.Header.Submitter)
+	}
+	f := g.Families[0]
+	if f.FatherId != "@I1@" || f.MotherId != "@X1@" {
+		t.Errorf("expected individual pointers to keep referring to the first individuals, got %s and %s", f.FatherId, f.MotherId)
+	}
+	if diagnostics[0].Rule != "duplicate-xref" || diagnostics[0].XRefId != "@I1@" {
+		t.Errorf("unexpected diagnostic: %v", diagnostics[0])
+	}
+}
+
+func TestValidateStructureLinkPointers(t *testing.T) {
+	g := interpretGedcomLines(linkLines)
+	diagnostics := g.ValidatePointerIntegrity(DanglingPointersReport)
+	reported := map[string]bool{}
+	for _, d := range diagnostics {
+		reported[d.XRefId] = true
+	}
+	if len(diagnostics) != 2 || !reported["@S404@"] || !reported["@N404@"] {
+		t.Errorf("expected dangling source and note pointers of the family to be reported, got %v", diagnostics)
+	}
+
+	g.ValidatePointerIntegrity(DanglingPointersRemove)
+	f := g.FamiliesByIds()["@F1@"]
+	if len(f.SourceCitations) != 0 || len(f.Notes) != 0 {
+		t.Errorf("expected dangling source citation and note to be removed, got %v and %v", f.SourceCitations, f.Notes)
+	}
+	if len(f.MarriageEvents[0].MultimediaLinks) != 1 {
+		t.Errorf("expected embedded multimedia link to be kept, got %v", f.MarriageEvents[0].MultimediaLinks)
+	}
+	if i := g.IndividualsByIds()["@I1@"]; len(i.SourceCitations) != 1 || len(i.BirthEvents[0].Notes) != 1 || len(i.MultimediaLinks) != 1 {
+		t.Errorf("expected valid pointers to be kept, got %+v", i)
+	}
+}
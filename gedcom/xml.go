@@ -0,0 +1,147 @@
+package gedcom
+
+import (
+	"encoding/xml"
+	"fmt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"io"
+	"strconv"
+)
+
+/*
+The XML representation of a gedcom mirrors the gedcom structure: every field of a message is an element named after
+the field, repeated fields are repeated elements and empty fields are left out. The root element is Gedcom.
+gedcom.xsd holds the schema of the XML representation, with a complex type for every message.
+*/
+
+const xmlRootElement = "Gedcom"
+
+func writeXMLMessage(encoder *xml.Encoder, name string, message protoreflect.Message) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	err := encoder.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !message.Has(field) {
+			continue
+		}
+		value := message.Get(field)
+		if field.IsList() {
+			list := value.List()
+			for j := 0; j < list.Len(); j++ {
+				err = writeXMLValue(encoder, field, list.Get(j))
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		err = writeXMLValue(encoder, field, value)
+		if err != nil {
+			return err
+		}
+	}
+	return encoder.EncodeToken(start.End())
+}
+
+func writeXMLValue(encoder *xml.Encoder, field protoreflect.FieldDescriptor, value protoreflect.Value) error {
+	name := string(field.Name())
+	if field.Kind() == protoreflect.MessageKind {
+		return writeXMLMessage(encoder, name, value.Message())
+	}
+	return encoder.EncodeElement(value.String(), xml.StartElement{Name: xml.Name{Local: name}})
+}
+
+// InterpretXML interprets the XML representation of a gedcom, without validating it.
+// Elements that aren't fields of the gedcom structure are skipped.
+func InterpretXML(inputReader io.Reader) (*ConcurrencySafeGedcom, error) {
+	decoder := xml.NewDecoder(inputReader)
+	g := NewConcurrencySafeGedcom()
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find root element %s with error: %s", xmlRootElement, err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if start.Name.Local != xmlRootElement {
+				return nil, fmt.Errorf("invalid root element %s, expected %s", start.Name.Local, xmlRootElement)
+			}
+			err = readXMLMessage(decoder, g.Gedcom.ProtoReflect())
+			if err != nil {
+				return nil, err
+			}
+			return g, nil
+		}
+	}
+}
+
+// readXMLMessage reads the fields of a message up to the end of its element
+func readXMLMessage(decoder *xml.Decoder, message protoreflect.Message) error {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			field := message.Descriptor().Fields().ByName(protoreflect.Name(t.Name.Local))
+			if field == nil {
+				err = decoder.Skip()
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			value, err := readXMLValue(decoder, t, field, message)
+			if err != nil {
+				return err
+			}
+			if field.IsList() {
+				message.Mutable(field).List().Append(value)
+			} else {
+				message.Set(field, value)
+			}
+		}
+	}
+}
+
+func readXMLValue(decoder *xml.Decoder, start xml.StartElement, field protoreflect.FieldDescriptor, message protoreflect.Message) (protoreflect.Value, error) {
+	if field.Kind() == protoreflect.MessageKind {
+		var value protoreflect.Value
+		if field.IsList() {
+			value = message.Mutable(field).List().NewElement()
+		} else {
+			value = message.NewField(field)
+		}
+		err := readXMLMessage(decoder, value.Message())
+		return value, err
+	}
+
+	var text string
+	err := decoder.DecodeElement(&text, &start)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid %s value %s with error: %s", field.Name(), text, err)
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind:
+		i, err := strconv.ParseInt(text, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid %s value %s with error: %s", field.Name(), text, err)
+		}
+		return protoreflect.ValueOfInt32(int32(i)), nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(text), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported kind %s of %s", field.Kind(), field.Name())
+}
@@ -0,0 +1,141 @@
+package gedcom
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var updateXSD = flag.Bool("update-xsd", false, "regenerate gedcom.xsd from the gedcom structure")
+
+func TestXMLSchemaIsCurrent(t *testing.T) {
+	generated := xmlSchema()
+	if *updateXSD {
+		if err := ioutil.WriteFile("gedcom.xsd", generated, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	shipped, err := ioutil.ReadFile("gedcom.xsd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(shipped, generated) {
+		t.Errorf("gedcom.xsd doesn't match gedcom.proto, regenerate it with: go test ./gedcom -update-xsd")
+	}
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	g := interpretGedcomLines(gedcom7Lines)
+	g.Individuals[0].BirthEvents[0].Primary = true
+	output, err := g.ToXML(nil)
+	if err != nil {
+		t.Fatalf("failed to write XML with error: %s", err)
+	}
+	serialized := string(*output)
+	for _, expected := range []string{
+		xml.Header + "<Gedcom>\n  <Header>\n",
+		"<Individuals>\n    <Id>@I1@</Id>\n",
+		"<Primary>true</Primary>",
+		"<Top>10</Top>",
+	} {
+		if !strings.Contains(serialized, expected) {
+			t.Errorf("XML doesn't contain %q:\n%s", expected, serialized)
+		}
+	}
+	if strings.Contains(serialized, "<Restriction></Restriction>") {
+		t.Errorf("XML contains empty fields:\n%s", serialized)
+	}
+
+	result, err := InterpretXML(bytes.NewReader(*output))
+	if err != nil {
+		t.Fatalf("failed to interpret XML with error: %s", err)
+	}
+	if !proto.Equal(&result.Gedcom, &g.Gedcom) {
+		t.Errorf("XML doesn't interpret to the same gedcom:\n%s", serialized)
+	}
+}
+
+func TestInterpretXML(t *testing.T) {
+	result, err := InterpretXML(strings.NewReader(`<?xml version="1.0"?>
+<Gedcom>
+  <Individuals>
+    <Id>@I1@</Id>
+    <Unknown><Nested>skipped</Nested></Unknown>
+    <Aliases>@I2@</Aliases>
+    <Aliases>Harry</Aliases>
+  </Individuals>
+</Gedcom>`))
+	if err != nil {
+		t.Fatalf("failed to interpret XML with error: %s", err)
+	}
+	expected := &Gedcom{Individuals: []*Gedcom_Individual{{Id: "@I1@", Aliases: []string{"@I2@", "Harry"}}}}
+	if !proto.Equal(&result.Gedcom, expected) {
+		t.Errorf("expected %v, found %v", expected, &result.Gedcom)
+	}
+
+	if _, err := InterpretXML(strings.NewReader("<Family/>")); err == nil {
+		t.Errorf("expected an error for root element Family")
+	}
+}
+
+// xmlSchemaTypes are the XSD types of the scalar kinds used by the gedcom structure
+var xmlSchemaTypes = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:   "xs:boolean",
+	protoreflect.Int32Kind:  "xs:int",
+	protoreflect.StringKind: "xs:string",
+}
+
+// xmlSchema generates the XSD of the XML representation of a gedcom, of which gedcom.xsd is a copy
+func xmlSchema() []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.WriteString(xml.Header)
+	buf.WriteString("<!-- Schema of the XML representation of a gedcom, mirroring gedcom.proto. Regenerate with: go test ./gedcom -update-xsd -->\n")
+	buf.WriteString(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" elementFormDefault="qualified">` + "\n")
+	buf.WriteString(fmt.Sprintf("  <xs:element name=\"%s\" type=\"%s\"/>\n", xmlRootElement, xmlSchemaTypeName((&Gedcom{}).ProtoReflect().Descriptor())))
+
+	var writeComplexTypes func(message protoreflect.MessageDescriptor)
+	writeComplexTypes = func(message protoreflect.MessageDescriptor) {
+		buf.WriteString(fmt.Sprintf("  <xs:complexType name=\"%s\">\n", xmlSchemaTypeName(message)))
+		buf.WriteString("    <xs:sequence>\n")
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			fieldType := xmlSchemaTypes[field.Kind()]
+			if field.Kind() == protoreflect.MessageKind {
+				fieldType = xmlSchemaTypeName(field.Message())
+			}
+			maxOccurs := "1"
+			if field.IsList() {
+				maxOccurs = "unbounded"
+			}
+			buf.WriteString(fmt.Sprintf("      <xs:element name=\"%s\" type=\"%s\" minOccurs=\"0\" maxOccurs=\"%s\"/>\n", field.Name(), fieldType, maxOccurs))
+		}
+		buf.WriteString("    </xs:sequence>\n")
+		buf.WriteString("  </xs:complexType>\n")
+
+		nested := message.Messages()
+		for i := 0; i < nested.Len(); i++ {
+			writeComplexTypes(nested.Get(i))
+		}
+	}
+	writeComplexTypes((&Gedcom{}).ProtoReflect().Descriptor())
+
+	buf.WriteString("</xs:schema>\n")
+	return buf.Bytes()
+}
+
+// xmlSchemaTypeName names the complex type of a message after its Go type, e.g. Gedcom_Individual_Event
+func xmlSchemaTypeName(message protoreflect.MessageDescriptor) string {
+	name := string(message.Name())
+	for parent, ok := message.Parent().(protoreflect.MessageDescriptor); ok; parent, ok = parent.Parent().(protoreflect.MessageDescriptor) {
+		name = string(parent.Name()) + "_" + name
+	}
+	return name
+}
@@ -0,0 +1,171 @@
+package gedcom
+
+// tags of the records that can be referred to by cross-reference identifiers
+const (
+	individualRecordTag = "INDI"
+	familyRecordTag     = "FAM"
+	multimediaRecordTag = "OBJE"
+	noteRecordTag       = "NOTE"
+	repositoryRecordTag = "REPO"
+	sourceRecordTag     = "SOUR"
+	submitterRecordTag  = "SUBM"
+	submissionRecordTag = "SUBN"
+)
+
+var recordTags = []string{
+	individualRecordTag,
+	familyRecordTag,
+	multimediaRecordTag,
+	noteRecordTag,
+	repositoryRecordTag,
+	sourceRecordTag,
+	submitterRecordTag,
+	submissionRecordTag,
+}
+
+// forEachRecordId calls fn for the id of every record, along with the tag of the record
+func forEachRecordId(gedcom *Gedcom, fn func(recordTag string, id *string)) {
+	for _, i := range gedcom.Individuals {
+		fn(individualRecordTag, &i.Id)
+	}
+	for _, f := range gedcom.Families {
+		fn(familyRecordTag, &f.Id)
+	}
+	for _, m := range gedcom.Multimedias {
+		fn(multimediaRecordTag, &m.Id)
+	}
+	for _, n := range gedcom.Notes {
+		fn(noteRecordTag, &n.Id)
+	}
+	for _, r := range gedcom.Repositories {
+		fn(repositoryRecordTag, &r.Id)
+	}
+	for _, s := range gedcom.Sources {
+		fn(sourceRecordTag, &s.Id)
+	}
+	for _, s := range gedcom.Submitters {
+		fn(submitterRecordTag, &s.Id)
+	}
+	if gedcom.Submission != nil {
+		fn(submissionRecordTag, &gedcom.Submission.Id)
+	}
+}
+
+// forEachPointer calls fn for every cross-reference pointer to a record,
+// along with the tag of the record it points to and the id of the record holding the pointer.
+// Empty pointers and values that aren't cross-reference identifiers, e.g. aliases holding names, are skipped.
+func forEachPointer(gedcom *Gedcom, fn func(recordTag string, pointer *string, holderId string)) {
+	forEachPointerField(gedcom, func(recordTag string, pointer *string, holderId string) {
+		if isXRefID(*pointer) {
+			fn(recordTag, pointer, holderId)
+		}
+	})
+}
+
+// forEachPointerField calls fn for every field that may hold a pointer to a record, whatever its value.
+func forEachPointerField(gedcom *Gedcom, visit func(recordTag string, pointer *string, holderId string)) {
+	visitNotes := func(notes []*Gedcom_NoteLink, holderId string) {
+		for _, n := range notes {
+			visit(noteRecordTag, &n.NoteId, holderId)
+		}
+	}
+	visitCitations := func(citations []*Gedcom_SourceCitation, holderId string) {
+		for _, c := range citations {
+			visit(sourceRecordTag, &c.SourceId, holderId)
+			visitNotes(c.Notes, holderId)
+		}
+	}
+	visitMultimediaLinks := func(links []*Gedcom_MultimediaLink, holderId string) {
+		for _, m := range links {
+			visit(multimediaRecordTag, &m.MultimediaId, holderId)
+		}
+	}
+	visitEvents := func(events []*Gedcom_Individual_Event, holderId string) {
+		for _, e := range events {
+			visitCitations(e.SourceCitations, holderId)
+			visitNotes(e.Notes, holderId)
+			visitMultimediaLinks(e.MultimediaLinks, holderId)
+		}
+	}
+	visitFamilyLinks := func(familyLinks []*Gedcom_Individual_FamilyLink, holderId string) {
+		for _, familyLink := range familyLinks {
+			visit(familyRecordTag, &familyLink.FamilyId, holderId)
+			visitNotes(familyLink.Notes, holderId)
+		}
+	}
+	visitNonEvents := func(nonEvents []*Gedcom_NonEvent, holderId string) {
+		for _, n := range nonEvents {
+			visitCitations(n.SourceCitations, holderId)
+			visitNotes(n.Notes, holderId)
+		}
+	}
+	visitOrdinances := func(ordinances []*Gedcom_LdsOrdinance, holderId string) {
+		for _, o := range ordinances {
+			visit(familyRecordTag, &o.FamilyId, holderId)
+			visitCitations(o.SourceCitations, holderId)
+			visitNotes(o.Notes, holderId)
+		}
+	}
+
+	if gedcom.Header != nil {
+		visit(submitterRecordTag, &gedcom.Header.Submitter, "")
+		visit(submissionRecordTag, &gedcom.Header.Submission, "")
+	}
+	if gedcom.Submission != nil {
+		visit(submitterRecordTag, &gedcom.Submission.SubmitterId, gedcom.Submission.Id)
+	}
+	for _, i := range gedcom.Individuals {
+		for _, eventType := range IndividualEventTypes {
+			visitEvents(*eventType.Events(i), i.Id)
+		}
+		visitFamilyLinks(i.ChildToFamilyLinks, i.Id)
+		visitFamilyLinks(i.SpouseToFamilyLinks, i.Id)
+		for j := range i.Aliases {
+			visit(individualRecordTag, &i.Aliases[j], i.Id)
+		}
+		for _, a := range i.Associations {
+			visit(individualRecordTag, &a.IndividualId, i.Id)
+			visitCitations(a.SourceCitations, i.Id)
+			visitNotes(a.Notes, i.Id)
+		}
+		visitOrdinances(i.LdsBaptisms, i.Id)
+		visitOrdinances(i.LdsConfirmations, i.Id)
+		visitOrdinances(i.LdsEndowments, i.Id)
+		visitOrdinances(i.LdsChildSealings, i.Id)
+		visitNonEvents(i.NonEvents, i.Id)
+		visitCitations(i.SourceCitations, i.Id)
+		visitNotes(i.Notes, i.Id)
+		visitMultimediaLinks(i.MultimediaLinks, i.Id)
+	}
+	for _, f := range gedcom.Families {
+		visit(individualRecordTag, &f.FatherId, f.Id)
+		visit(individualRecordTag, &f.MotherId, f.Id)
+		for j := range f.ChildIds {
+			visit(individualRecordTag, &f.ChildIds[j], f.Id)
+		}
+		visitEvents(f.MarriageEvents, f.Id)
+		visitOrdinances(f.LdsSpouseSealings, f.Id)
+		visitNonEvents(f.NonEvents, f.Id)
+		visitCitations(f.SourceCitations, f.Id)
+		visitNotes(f.Notes, f.Id)
+		visitMultimediaLinks(f.MultimediaLinks, f.Id)
+	}
+	for _, m := range gedcom.Multimedias {
+		visitCitations(m.SourceCitations, m.Id)
+		visitNotes(m.Notes, m.Id)
+	}
+	for _, r := range gedcom.Repositories {
+		visitNotes(r.Notes, r.Id)
+	}
+	for _, s := range gedcom.Sources {
+		for _, r := range s.RepositoryCitations {
+			visit(repositoryRecordTag, &r.RepositoryId, s.Id)
+			visitNotes(r.Notes, s.Id)
+		}
+		visitNotes(s.Notes, s.Id)
+		visitMultimediaLinks(s.MultimediaLinks, s.Id)
+	}
+	for _, s := range gedcom.Submitters {
+		visitMultimediaLinks(s.MultimediaLinks, s.Id)
+	}
+}
@@ -0,0 +1,153 @@
+package gedcom
+
+import (
+	"fmt"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strconv"
+)
+
+/*
+The YAML representation of a gedcom mirrors its json representation: every field of a message is a key named after the
+field, in the order of the gedcom structure, repeated fields are sequences and empty fields are left out.
+Scalars are read as the type of their field, so e.g. years don't need to be quoted when editing YAML by hand.
+*/
+
+func yamlMessageNode(message protoreflect.Message) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !message.Has(field) {
+			continue
+		}
+		value := message.Get(field)
+		var valueNode *yaml.Node
+		if field.IsList() {
+			valueNode = &yaml.Node{Kind: yaml.SequenceNode}
+			list := value.List()
+			for j := 0; j < list.Len(); j++ {
+				valueNode.Content = append(valueNode.Content, yamlValueNode(field, list.Get(j)))
+			}
+		} else {
+			valueNode = yamlValueNode(field, value)
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: string(field.Name())}, valueNode)
+	}
+	return node
+}
+
+func yamlValueNode(field protoreflect.FieldDescriptor, value protoreflect.Value) *yaml.Node {
+	switch field.Kind() {
+	case protoreflect.MessageKind:
+		return yamlMessageNode(value.Message())
+	case protoreflect.BoolKind:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: value.String()}
+	case protoreflect.Int32Kind:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: value.String()}
+	}
+	// strings that would read as another type, e.g. years, are quoted
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value.String()}
+}
+
+// InterpretYAML interprets the YAML representation of a gedcom, without validating it.
+// Keys that aren't fields of the gedcom structure are skipped.
+func InterpretYAML(inputReader io.Reader) (*ConcurrencySafeGedcom, error) {
+	g := NewConcurrencySafeGedcom()
+	document := &yaml.Node{}
+	err := yaml.NewDecoder(inputReader).Decode(document)
+	if err == io.EOF || err == nil && len(document.Content) == 0 {
+		return g, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = readYAMLMessage(document.Content[0], g.Gedcom.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// resolveYAMLNode resolves aliases to the nodes they refer to
+func resolveYAMLNode(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+func isYAMLNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
+
+func readYAMLMessage(node *yaml.Node, message protoreflect.Message) error {
+	node = resolveYAMLNode(node)
+	if isYAMLNull(node) {
+		return nil
+	}
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("invalid %s on line %d, expected a mapping", message.Descriptor().Name(), node.Line)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, valueNode := node.Content[i], resolveYAMLNode(node.Content[i+1])
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(key.Value))
+		if field == nil || isYAMLNull(valueNode) {
+			continue
+		}
+		if !field.IsList() {
+			value, err := readYAMLValue(valueNode, field, message)
+			if err != nil {
+				return err
+			}
+			message.Set(field, value)
+			continue
+		}
+		if valueNode.Kind != yaml.SequenceNode {
+			return fmt.Errorf("invalid %s on line %d, expected a sequence", field.Name(), valueNode.Line)
+		}
+		for _, elementNode := range valueNode.Content {
+			value, err := readYAMLValue(resolveYAMLNode(elementNode), field, message)
+			if err != nil {
+				return err
+			}
+			message.Mutable(field).List().Append(value)
+		}
+	}
+	return nil
+}
+
+func readYAMLValue(node *yaml.Node, field protoreflect.FieldDescriptor, message protoreflect.Message) (protoreflect.Value, error) {
+	if field.Kind() == protoreflect.MessageKind {
+		var value protoreflect.Value
+		if field.IsList() {
+			value = message.Mutable(field).List().NewElement()
+		} else {
+			value = message.NewField(field)
+		}
+		err := readYAMLMessage(node, value.Message())
+		return value, err
+	}
+
+	if node.Kind != yaml.ScalarNode {
+		return protoreflect.Value{}, fmt.Errorf("invalid %s on line %d, expected a scalar", field.Name(), node.Line)
+	}
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(node.Value)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid %s value %s on line %d with error: %s", field.Name(), node.Value, node.Line, err)
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind:
+		i, err := strconv.ParseInt(node.Value, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid %s value %s on line %d with error: %s", field.Name(), node.Value, node.Line, err)
+		}
+		return protoreflect.ValueOfInt32(int32(i)), nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(node.Value), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported kind %s of %s", field.Kind(), field.Name())
+}
@@ -0,0 +1,101 @@
+package gedcom
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestYAMLRoundTrip(t *testing.T) {
+	g := interpretGedcomLines([]string{
+		"0 HEAD",
+		"1 GEDC",
+		"2 VERS 5.5.1",
+		"0 @I1@ INDI",
+		"1 NAME John /Smith/",
+		"1 SEX M",
+		"1 BIRT",
+		"2 DATE 1 JAN 1900",
+		"2 PLAC London",
+		"1 FAMS @F1@",
+		"0 @F1@ FAM",
+		"1 HUSB @I1@",
+		"0 TRLR",
+	})
+	g.Submitters = []*Gedcom_Submitter{{
+		Id: "@U1@",
+		MultimediaLinks: []*Gedcom_MultimediaLink{{
+			MultimediaId: "@M1@",
+			Crop:         &Gedcom_MultimediaLink_CropType{Top: 10, Width: 200},
+		}},
+	}}
+	g.Individuals[0].Names[0].Primary = true
+
+	gedcomYAML, err := g.ToYAML(nil)
+	if err != nil {
+		t.Fatalf("failed to export YAML with error: %s", err)
+	}
+	for _, expected := range []string{
+		"Individuals:\n  - Id: '@I1@'\n    Names:\n      - GivenName: John\n        Surname: Smith\n        Primary: true\n    Gender: MALE\n",
+		"      - Date:\n          Year: \"1900\"\n          Month: \"01\"\n          Day: \"1\"\n        Place: London\n",
+		"        Crop:\n          Top: 10\n          Width: 200\n",
+	} {
+		if !strings.Contains(string(*gedcomYAML), expected) {
+			t.Errorf("expected YAML to contain %q, found %s", expected, *gedcomYAML)
+		}
+	}
+
+	result, err := InterpretYAML(bytes.NewReader(*gedcomYAML))
+	if err != nil {
+		t.Fatalf("failed to interpret YAML with error: %s", err)
+	}
+	if !proto.Equal(&result.Gedcom, &g.Gedcom) {
+		t.Errorf("expected %v, found %v", &g.Gedcom, &result.Gedcom)
+	}
+}
+
+func TestInterpretYAML(t *testing.T) {
+	gedcomYAML := strings.Join([]string{
+		"# hand-edited fixture",
+		"Individuals:",
+		"  - Id: '@I1@'",
+		"    Unknown: skipped",
+		"    BirthEvents:",
+		"      - Date: &date",
+		"          Year: 1900",
+		"    DeathEvents:",
+		"      - Date: *date",
+		"Families:",
+		"Submission: ~",
+	}, "\n")
+	result, err := InterpretYAML(strings.NewReader(gedcomYAML))
+	if err != nil {
+		t.Fatalf("failed to interpret YAML with error: %s", err)
+	}
+	expected := &Gedcom{Individuals: []*Gedcom_Individual{{
+		Id:          "@I1@",
+		BirthEvents: []*Gedcom_Individual_Event{{Date: &Gedcom_Individual_Date{Year: "1900"}}},
+		DeathEvents: []*Gedcom_Individual_Event{{Date: &Gedcom_Individual_Date{Year: "1900"}}},
+	}}}
+	if !proto.Equal(&result.Gedcom, expected) {
+		t.Errorf("expected %v, found %v", expected, &result.Gedcom)
+	}
+
+	for _, invalid := range []string{
+		"Individuals: '@I1@'",
+		"Individuals:\n  - Names: John",
+		"Individuals:\n  - Names:\n      - Primary: maybe",
+		"Submitters:\n  - MultimediaLinks:\n      - Crop:\n          Top: 1.5",
+		"Individuals: [",
+	} {
+		if _, err := InterpretYAML(strings.NewReader(invalid)); err == nil {
+			t.Errorf("expected an error interpreting %q", invalid)
+		}
+	}
+
+	if empty, err := InterpretYAML(strings.NewReader("")); err != nil || len(empty.Individuals) > 0 {
+		t.Errorf("expected an empty gedcom from empty YAML, found %v with error %v", empty, err)
+	}
+}
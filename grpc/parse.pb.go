@@ -30,8 +30,16 @@ type PathsToFiles struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InputFilePath  string `protobuf:"bytes,1,opt,name=inputFilePath,proto3" json:"inputFilePath,omitempty"`
-	OutputFilePath string `protobuf:"bytes,2,opt,name=outputFilePath,proto3" json:"outputFilePath,omitempty"`
+	InputFilePath     string `protobuf:"bytes,1,opt,name=inputFilePath,proto3" json:"inputFilePath,omitempty"`
+	OutputFilePath    string `protobuf:"bytes,2,opt,name=outputFilePath,proto3" json:"outputFilePath,omitempty"`
+	ApplyRestrictions bool   `protobuf:"varint,3,opt,name=applyRestrictions,proto3" json:"applyRestrictions,omitempty"`
+	Living            string `protobuf:"bytes,4,opt,name=living,proto3" json:"living,omitempty"`
+	LivingMaxAge      int32  `protobuf:"varint,5,opt,name=livingMaxAge,proto3" json:"livingMaxAge,omitempty"`
+	DanglingPointers  string `protobuf:"bytes,6,opt,name=danglingPointers,proto3" json:"danglingPointers,omitempty"`
+	Lint              bool   `protobuf:"varint,7,opt,name=lint,proto3" json:"lint,omitempty"`
+	AncestryCycles    string `protobuf:"bytes,8,opt,name=ancestryCycles,proto3" json:"ancestryCycles,omitempty"`
+	GedcomVersion     string `protobuf:"bytes,9,opt,name=gedcomVersion,proto3" json:"gedcomVersion,omitempty"`
+	Format            string `protobuf:"bytes,10,opt,name=format,proto3" json:"format,omitempty"`
 }
 
 func (x *PathsToFiles) Reset() {
@@ -80,6 +88,62 @@ func (x *PathsToFiles) GetOutputFilePath() string {
 	return ""
 }
 
+func (x *PathsToFiles) GetApplyRestrictions() bool {
+	if x != nil {
+		return x.ApplyRestrictions
+	}
+	return false
+}
+
+func (x *PathsToFiles) GetLiving() string {
+	if x != nil {
+		return x.Living
+	}
+	return ""
+}
+
+func (x *PathsToFiles) GetLivingMaxAge() int32 {
+	if x != nil {
+		return x.LivingMaxAge
+	}
+	return 0
+}
+
+func (x *PathsToFiles) GetDanglingPointers() string {
+	if x != nil {
+		return x.DanglingPointers
+	}
+	return ""
+}
+
+func (x *PathsToFiles) GetLint() bool {
+	if x != nil {
+		return x.Lint
+	}
+	return false
+}
+
+func (x *PathsToFiles) GetAncestryCycles() string {
+	if x != nil {
+		return x.AncestryCycles
+	}
+	return ""
+}
+
+func (x *PathsToFiles) GetGedcomVersion() string {
+	if x != nil {
+		return x.GedcomVersion
+	}
+	return ""
+}
+
+func (x *PathsToFiles) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type Result struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -139,24 +203,44 @@ var File_grpc_parse_proto protoreflect.FileDescriptor
 
 var file_grpc_parse_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70, 0x63, 0x22, 0x5c, 0x0a, 0x0c, 0x50, 0x61, 0x74, 0x68,
-	0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26,
-	0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x32, 0x3b, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x2b, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x0c, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x2e, 0x5a,
-	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x6f, 0x63, 0x68,
-	0x65, 0x6e, 0x62, 0x6f, 0x65, 0x73, 0x6d, 0x61, 0x6e, 0x73, 0x2f, 0x67, 0x65, 0x64, 0x63, 0x6f,
-	0x6d, 0x2d, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70, 0x63, 0x22, 0xec, 0x02, 0x0a, 0x0c, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x26, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a,
+	0x0c, 0x6c, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x61, 0x6e,
+	0x67, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x72, 0x79, 0x43, 0x79, 0x63,
+	0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x72, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x65, 0x64,
+	0x63, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x67, 0x65, 0x64, 0x63, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x38, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x32, 0x6b, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x12, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x0c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x08, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x0c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x42, 0x2e,
+	0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x6f, 0x63,
+	0x68, 0x65, 0x6e, 0x62, 0x6f, 0x65, 0x73, 0x6d, 0x61, 0x6e, 0x73, 0x2f, 0x67, 0x65, 0x64, 0x63,
+	0x6f, 0x6d, 0x2d, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -178,9 +262,11 @@ var file_grpc_parse_proto_goTypes = []interface{}{
 }
 var file_grpc_parse_proto_depIdxs = []int32{
 	0, // 0: grpc.ParseService.Parse:input_type -> grpc.PathsToFiles
-	1, // 1: grpc.ParseService.Parse:output_type -> grpc.Result
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	0, // 1: grpc.ParseService.Validate:input_type -> grpc.PathsToFiles
+	1, // 2: grpc.ParseService.Parse:output_type -> grpc.Result
+	1, // 3: grpc.ParseService.Validate:output_type -> grpc.Result
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -19,6 +19,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ParseServiceClient interface {
 	Parse(ctx context.Context, in *PathsToFiles, opts ...grpc.CallOption) (*Result, error)
+	Validate(ctx context.Context, in *PathsToFiles, opts ...grpc.CallOption) (*Result, error)
 }
 
 type parseServiceClient struct {
@@ -38,11 +39,21 @@ func (c *parseServiceClient) Parse(ctx context.Context, in *PathsToFiles, opts .
 	return out, nil
 }
 
+func (c *parseServiceClient) Validate(ctx context.Context, in *PathsToFiles, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/grpc.ParseService/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ParseServiceServer is the server API for ParseService service.
 // All implementations must embed UnimplementedParseServiceServer
 // for forward compatibility
 type ParseServiceServer interface {
 	Parse(context.Context, *PathsToFiles) (*Result, error)
+	Validate(context.Context, *PathsToFiles) (*Result, error)
 	mustEmbedUnimplementedParseServiceServer()
 }
 
@@ -53,6 +64,9 @@ type UnimplementedParseServiceServer struct {
 func (UnimplementedParseServiceServer) Parse(context.Context, *PathsToFiles) (*Result, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
 }
+func (UnimplementedParseServiceServer) Validate(context.Context, *PathsToFiles) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
 func (UnimplementedParseServiceServer) mustEmbedUnimplementedParseServiceServer() {}
 
 // UnsafeParseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +98,24 @@ func _ParseService_Parse_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ParseService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathsToFiles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ParseServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.ParseService/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ParseServiceServer).Validate(ctx, req.(*PathsToFiles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ParseService_ServiceDesc is the grpc.ServiceDesc for ParseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,6 +127,10 @@ var ParseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Parse",
 			Handler:    _ParseService_Parse_Handler,
 		},
+		{
+			MethodName: "Validate",
+			Handler:    _ParseService_Validate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/parse.proto",
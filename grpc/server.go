@@ -2,10 +2,12 @@ package grpc
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
 	"github.com/jochenboesmans/gedcom-parser/parse"
 	remoteFileStorage "github.com/jochenboesmans/gedcom-parser/remote-file-storage"
 	"golang.org/x/net/context"
@@ -13,7 +15,6 @@ import (
 	"log"
 	"net"
 	"os"
-	"path/filepath"
 )
 
 type Server struct {
@@ -31,6 +32,62 @@ func (s *Server) init() {
 
 func (s *Server) Parse(_ context.Context, paths *PathsToFiles) (*Result, error) {
 	log.Printf("started parsing %s to %s", paths.InputFilePath, paths.OutputFilePath)
+
+	livingPolicy, err := gedcomSpec.ParseLivingPolicy(paths.Living)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to parse export options: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+	danglingPointerPolicy, err := gedcomSpec.ParseDanglingPointerPolicy(paths.DanglingPointers)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to parse validate options: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+	ancestryCyclePolicy, err := gedcomSpec.ParseAncestryCyclePolicy(paths.AncestryCycles)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to parse validate options: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+	version, err := gedcomSpec.ParseGedcomVersion(paths.GedcomVersion)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to parse export options: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+	jsonFormat, err := gedcomSpec.ParseJSONFormat(paths.Format)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to parse export options: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+	validateOptions := &gedcomSpec.ValidateOptions{
+		DanglingPointers: danglingPointerPolicy,
+		AncestryCycles:   ancestryCyclePolicy,
+	}
+	if paths.Lint {
+		validateOptions.Lint = &gedcomSpec.LintOptions{}
+	}
+	exportOptions := &gedcomSpec.ExportOptions{
+		ApplyRestrictions: paths.ApplyRestrictions,
+		Living:            livingPolicy,
+		LivingMaxAge:      int(paths.LivingMaxAge),
+		Version:           version,
+		JSONFormat:        jsonFormat,
+	}
+
 	log.Printf("reading from s3 bucket at %s...\n", paths.InputFilePath)
 	input, err := remoteFileStorage.S3Read(paths.InputFilePath, s.downloader)
 	if err != nil {
@@ -44,10 +101,10 @@ func (s *Server) Parse(_ context.Context, paths *PathsToFiles) (*Result, error)
 	var output *[]byte
 	inputReader := bytes.NewReader(*input)
 
-	switch filepath.Ext(paths.InputFilePath) {
+	switch parse.FileExtension(paths.InputFilePath, exportOptions) {
 	case ".ged":
 		log.Printf("parsing gedcom...\n")
-		output, err = parse.ParseGedcom(inputReader, paths.OutputFilePath)
+		output, err = parse.ParseGedcom(inputReader, paths.OutputFilePath, validateOptions, exportOptions)
 		if err != nil {
 			errMessage := fmt.Sprintf("failed to parse gedcom: %s", err)
 			log.Println(errMessage)
@@ -57,7 +114,7 @@ func (s *Server) Parse(_ context.Context, paths *PathsToFiles) (*Result, error)
 		}
 	case ".json":
 		log.Printf("parsing json...\n")
-		output, err = parse.ParseJSON(inputReader)
+		output, err = parse.ParseJSON(inputReader, paths.OutputFilePath, validateOptions, exportOptions)
 		if err != nil {
 			errMessage := fmt.Sprintf("failed to parse json: %s", err)
 			log.Println(errMessage)
@@ -65,8 +122,58 @@ func (s *Server) Parse(_ context.Context, paths *PathsToFiles) (*Result, error)
 				Error: errMessage,
 			}, nil
 		}
+	case ".gdz":
+		log.Printf("parsing gedzip...\n")
+		output, err = parse.ParseGedzip(inputReader, inputReader.Size(), paths.OutputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			errMessage := fmt.Sprintf("failed to parse gedzip: %s", err)
+			log.Println(errMessage)
+			return &Result{
+				Error: errMessage,
+			}, nil
+		}
+	case parse.GedcomXExtension:
+		log.Printf("parsing gedcom x...\n")
+		output, err = parse.ParseGedcomX(inputReader, paths.OutputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			errMessage := fmt.Sprintf("failed to parse gedcom x: %s", err)
+			log.Println(errMessage)
+			return &Result{
+				Error: errMessage,
+			}, nil
+		}
+	case ".xml":
+		log.Printf("parsing xml...\n")
+		output, err = parse.ParseXML(inputReader, paths.OutputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			errMessage := fmt.Sprintf("failed to parse xml: %s", err)
+			log.Println(errMessage)
+			return &Result{
+				Error: errMessage,
+			}, nil
+		}
+	case ".ndjson":
+		log.Printf("parsing ndjson...\n")
+		output, err = parse.ParseNDJSON(inputReader, paths.OutputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			errMessage := fmt.Sprintf("failed to parse ndjson: %s", err)
+			log.Println(errMessage)
+			return &Result{
+				Error: errMessage,
+			}, nil
+		}
+	case ".yaml":
+		log.Printf("parsing yaml...\n")
+		output, err = parse.ParseYAML(inputReader, paths.OutputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			errMessage := fmt.Sprintf("failed to parse yaml: %s", err)
+			log.Println(errMessage)
+			return &Result{
+				Error: errMessage,
+			}, nil
+		}
 	default:
-		errMessage := fmt.Sprintf("failed to match input file extension to: %s", ".ged|.json")
+		errMessage := fmt.Sprintf("failed to match input file extension to: %s", ".ged|.json|.gdz|.gedx.json|.xml|.ndjson|.yaml|.yml")
 		log.Println(errMessage)
 		return &Result{
 			Error: errMessage,
@@ -90,6 +197,57 @@ func (s *Server) Parse(_ context.Context, paths *PathsToFiles) (*Result, error)
 	}, nil
 }
 
+// Validate checks a GEDCOM file against the GEDCOM 5.5.1 grammar and writes the conformance report as json to the output file
+func (s *Server) Validate(_ context.Context, paths *PathsToFiles) (*Result, error) {
+	log.Printf("started validating %s to %s", paths.InputFilePath, paths.OutputFilePath)
+
+	log.Printf("reading from s3 bucket at %s...\n", paths.InputFilePath)
+	input, err := remoteFileStorage.S3Read(paths.InputFilePath, s.downloader)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to read from s3: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+
+	report, err := parse.CheckConformance(bytes.NewReader(*input))
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to validate gedcom: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+	output, err := json.Marshal(report)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to marshal conformance report: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+
+	log.Printf("writing to s3 bucket at %s...\n", paths.OutputFilePath)
+	_, err = remoteFileStorage.S3Write(paths.OutputFilePath, &output, s.uploader)
+	if err != nil {
+		errMessage := fmt.Sprintf("failed to write to s3: %s", err)
+		log.Println(errMessage)
+		return &Result{
+			Error: errMessage,
+		}, nil
+	}
+
+	log.Printf("finished validating %s to %s", paths.InputFilePath, paths.OutputFilePath)
+	conformance := "conforms"
+	if !report.Conforming {
+		conformance = "doesn't conform"
+	}
+	return &Result{
+		Message: fmt.Sprintf("%s %s to GEDCOM %s, wrote conformance report to %s", paths.InputFilePath, conformance, report.Version, paths.OutputFilePath),
+	}, nil
+}
+
 func Serve() {
 	lis, err := net.Listen("tcp", ":9000")
 	if err != nil {
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
 	"github.com/jochenboesmans/gedcom-parser/grpc"
 	"github.com/jochenboesmans/gedcom-parser/parse"
+	"github.com/jochenboesmans/gedcom-parser/site"
 	"github.com/joho/godotenv"
 	"log"
 	"os"
+	"strings"
 )
 
 func init() {
@@ -18,23 +24,212 @@ func main() {
 	checkMainArg()
 	switch os.Args[1] {
 	case "parse":
-		checkFilepathArgs()
-		parse.Parse(os.Args[2], os.Args[3])
+		parseCommand := flag.NewFlagSet("parse", flag.ExitOnError)
+		applyRestrictions := parseCommand.Bool("apply-restrictions", false, "omit confidential data and redact data restricted for privacy (RESN)")
+		living := parseCommand.String("living", "keep", "what to do with individuals who might still be alive: keep|redact|remove")
+		livingMaxAge := parseCommand.Int("living-max-age", gedcomSpec.DefaultLivingMaxAge, "age in years from which individuals without a death event are presumed dead")
+		danglingPointers := parseCommand.String("dangling-pointers", "remove", "what to do with pointers to records that don't exist: report|remove|placeholder")
+		ancestryCycles := parseCommand.String("ancestry-cycles", "report", "what to do with individuals who are their own ancestor: report|break")
+		lint := parseCommand.Bool("lint", false, "report biologically or chronologically implausible data")
+		gedcomVersion := parseCommand.String("gedcom-version", "5.5.1", "version of the GEDCOM standard GEDCOM output files are written in: 5.5.1|7.0")
+		format := parseCommand.String("format", "gedcom", "format of JSON input and output files: gedcom|gedcomx")
+		csvDelimiter := parseCommand.String("csv-delimiter", "", "delimiter of CSV output files: a single character or tab, defaults to a comma or to a tab for .tsv files")
+		packageMedia := parseCommand.Bool("package-media", false, "package the local media files referenced by multimedia files into GEDZIP output files")
+		graphRoot := parseCommand.String("graph-root", "", "xref of the individual DOT output files start from, e.g. @I1@")
+		graphDepth := parseCommand.Int("graph-depth", 0, "amount of generations from the root individual DOT output files hold, 0 for all")
+		graphDirection := parseCommand.String("graph-direction", "both", "relatives of the root individual DOT output files hold: both|ancestors|descendants")
+		graphColorByGender := parseCommand.Bool("graph-color-by-gender", false, "colour the individuals of DOT output files by gender")
+		baseURI := parseCommand.String("base-uri", gedcomSpec.DefaultBaseURI, "base of the IRIs of records in RDF output files, e.g. https://example.org/tree/")
+		_ = parseCommand.Parse(os.Args[2:])
+		checkFilepathArgs(parseCommand.Args())
+		livingPolicy, err := gedcomSpec.ParseLivingPolicy(*living)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		danglingPointerPolicy, err := gedcomSpec.ParseDanglingPointerPolicy(*danglingPointers)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		ancestryCyclePolicy, err := gedcomSpec.ParseAncestryCyclePolicy(*ancestryCycles)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		version, err := gedcomSpec.ParseGedcomVersion(*gedcomVersion)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		jsonFormat, err := gedcomSpec.ParseJSONFormat(*format)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		delimiter, err := gedcomSpec.ParseCSVDelimiter(*csvDelimiter)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		direction, err := gedcomSpec.ParseGraphDirection(*graphDirection)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		rdfBaseURI, err := gedcomSpec.ParseBaseURI(*baseURI)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		validateOptions := &gedcomSpec.ValidateOptions{
+			DanglingPointers: danglingPointerPolicy,
+			AncestryCycles:   ancestryCyclePolicy,
+		}
+		if *lint {
+			validateOptions.Lint = &gedcomSpec.LintOptions{}
+		}
+		exportOptions := &gedcomSpec.ExportOptions{
+			ApplyRestrictions: *applyRestrictions,
+			Living:            livingPolicy,
+			LivingMaxAge:      *livingMaxAge,
+			Version:           version,
+			PackageMedia:      *packageMedia,
+			JSONFormat:        jsonFormat,
+			CSVDelimiter:      delimiter,
+			BaseURI:           rdfBaseURI,
+			Graph: &gedcomSpec.GraphOptions{
+				RootId:        *graphRoot,
+				Depth:         *graphDepth,
+				Direction:     direction,
+				ColorByGender: *graphColorByGender,
+			},
+		}
+		parse.Parse(parseCommand.Arg(0), parseCommand.Arg(1), validateOptions, exportOptions)
+	case "lint":
+		lintCommand := flag.NewFlagSet("lint", flag.ExitOnError)
+		disable := lintCommand.String("disable", "", "comma separated rules not to check: "+strings.Join(gedcomSpec.LintRules, "|"))
+		minParentAge := lintCommand.Int("min-parent-age", gedcomSpec.DefaultMinParentAge, "minimum age in years of parents at the birth of a child")
+		maxParentAge := lintCommand.Int("max-parent-age", gedcomSpec.DefaultMaxParentAge, "maximum age in years of parents at the birth of a child")
+		maxLifespan := lintCommand.Int("max-lifespan", gedcomSpec.DefaultMaxLifespan, "maximum lifespan in years")
+		minMarriageAge := lintCommand.Int("min-marriage-age", gedcomSpec.DefaultMinMarriageAge, "minimum age in years of spouses at their marriage")
+		_ = lintCommand.Parse(os.Args[2:])
+		checkInputFilepathArg(lintCommand.Args())
+		disabledRules, err := gedcomSpec.ParseLintRules(*disable)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		gedcom, err := parse.Read(lintCommand.Arg(0))
+		if err != nil {
+			log.Fatalln(err)
+		}
+		diagnostics := gedcom.Validate(&gedcomSpec.ValidateOptions{
+			DanglingPointers: gedcomSpec.DanglingPointersReport,
+			Lint: &gedcomSpec.LintOptions{
+				DisabledRules:  disabledRules,
+				MinParentAge:   *minParentAge,
+				MaxParentAge:   *maxParentAge,
+				MaxLifespan:    *maxLifespan,
+				MinMarriageAge: *minMarriageAge,
+			},
+		})
+		for _, d := range diagnostics {
+			fmt.Println(d)
+		}
+	case "site":
+		siteCommand := flag.NewFlagSet("site", flag.ExitOnError)
+		applyRestrictions := siteCommand.Bool("apply-restrictions", false, "omit confidential data and redact data restricted for privacy (RESN)")
+		living := siteCommand.String("living", "keep", "what to do with individuals who might still be alive: keep|redact|remove")
+		livingMaxAge := siteCommand.Int("living-max-age", gedcomSpec.DefaultLivingMaxAge, "age in years from which individuals without a death event are presumed dead")
+		_ = siteCommand.Parse(os.Args[2:])
+		checkFilepathArgs(siteCommand.Args())
+		livingPolicy, err := gedcomSpec.ParseLivingPolicy(*living)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		gedcom, err := parse.Read(siteCommand.Arg(0))
+		if err != nil {
+			log.Fatalln(err)
+		}
+		for _, d := range gedcom.Validate(&gedcomSpec.ValidateOptions{DanglingPointers: gedcomSpec.DanglingPointersRemove}) {
+			log.Println(d)
+		}
+		err = site.Write(gedcom, siteCommand.Arg(1), &gedcomSpec.ExportOptions{
+			ApplyRestrictions: *applyRestrictions,
+			Living:            livingPolicy,
+			LivingMaxAge:      *livingMaxAge,
+		})
+		if err != nil {
+			log.Fatalln(err)
+		}
+	case "validate":
+		validateCommand := flag.NewFlagSet("validate", flag.ExitOnError)
+		format := validateCommand.String("format", "text", "format of the conformance report: text|json")
+		_ = validateCommand.Parse(os.Args[2:])
+		checkInputFilepathArg(validateCommand.Args())
+		input, err := os.Open(validateCommand.Arg(0))
+		if err != nil {
+			log.Fatalln(err)
+		}
+		report, err := parse.CheckConformance(input)
+		_ = input.Close()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		switch *format {
+		case "text":
+			fmt.Print(report)
+		case "json":
+			reportJson, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				log.Fatalln(err)
+			}
+			fmt.Println(string(reportJson))
+		default:
+			log.Fatalf("invalid report format %s, expected one of: text|json\n", *format)
+		}
+		if !report.Conforming {
+			os.Exit(1)
+		}
 	case "serve":
 		grpc.Serve()
 	case "help":
 		helpMessage := `
-		Usage: 'gedcom-parser <command> <inputFilePath> <outputFilePath>'
+		Usage: 'gedcom-parser <command> [<options>] <inputFilePath> <outputFilePath>'
 
 		* <command> [REQUIRED]:
 			parse - Parse local files. Requires the inputFilePath and outputFilePath to be specified.
+			lint - Report issues and implausible data in a local file. Requires the inputFilePath to be specified.
+			site - Generate a static HTML website of a local file. Requires the inputFilePath to be specified, along with the directory of the website as outputFilePath.
+			validate - Check a local GEDCOM file against the GEDCOM 5.5.1 grammar, exiting with status 1 if it doesn't conform. Requires the inputFilePath to be specified.
 			serve - Start a gRPC server for gedcom parsing on remote file storage.
 
+		* <options> [OPTIONAL]:
+			-apply-restrictions - Omit confidential data and redact data restricted for privacy by restriction notices (RESN).
+			-living keep|redact|remove - Keep, redact or remove individuals who might still be alive. Defaults to keep.
+			-living-max-age <years> - Age from which individuals without a death event are presumed dead. Defaults to 100.
+			-dangling-pointers report|remove|placeholder - Report, remove or create placeholder records for pointers to records that don't exist. Defaults to remove.
+			-ancestry-cycles report|break - Report individuals who are their own ancestor, or break those cycles by removing the child that closes them from its family. Defaults to report.
+			-lint - Report biologically or chronologically implausible data.
+			-gedcom-version 5.5.1|7.0 - Version of the GEDCOM standard GEDCOM output files are written in. Defaults to 5.5.1.
+			-format gedcom|gedcomx - Format of JSON input and output files, either the gedcom structure itself or GEDCOM X JSON. Files named *.gedx.json are always GEDCOM X JSON. Defaults to gedcom.
+			-csv-delimiter <delimiter>|tab - Delimiter of CSV output files (.csv). Defaults to a comma, or to a tab for tab-separated output files (.tsv).
+			-package-media - Package the local media files referenced by multimedia files (OBJE FILE) into GEDZIP output files (.gdz). Relative references are resolved against the directory of the input file.
+			-graph-root <xref> - Individual DOT output files (.dot) start from, e.g. @I1@. Defaults to none, graphing every individual.
+			-graph-depth <generations> - Amount of generations from the root individual DOT output files hold. Defaults to 0, holding all generations.
+			-graph-direction both|ancestors|descendants - Relatives of the root individual DOT output files hold. Descendants are graphed along with their spouses. Defaults to both.
+			-graph-color-by-gender - Colour the individuals of DOT output files by gender.
+			-base-uri <uri> - Base of the IRIs of records in RDF output files (.ttl or .jsonld), which end in their xref without @s. Defaults to urn:gedcom:.
+
+		* <options> of lint [OPTIONAL]:
+			-disable <rules> - Comma separated rules not to check: death-before-birth, parent-age, lifespan, birth-after-mother-death, marriage-age, event-after-burial.
+			-min-parent-age <years>, -max-parent-age <years> - Age range of parents at the birth of a child. Defaults to 12 and 70.
+			-max-lifespan <years> - Maximum lifespan. Defaults to 120.
+			-min-marriage-age <years> - Minimum age of spouses at their marriage. Defaults to 12.
+
+		* <options> of site [OPTIONAL]:
+			-apply-restrictions, -living keep|redact|remove, -living-max-age <years> - Which data the website holds, as for parse.
+
+		* <options> of validate [OPTIONAL]:
+			-format text|json - Format of the conformance report. Defaults to text.
+
 		* <inputFilePath> [OPTIONAL]:
-			Relative path to the input file to parse. Please make sure to use the file extensions .ged, .json and .protobuf for respectively GEDCOM, JSON and Protobuf files.
+			Relative path to the input file to parse. Please make sure to use the file extensions .ged, .json, .gdz, .gedx.json, .xml, .sqlite, .ndjson and .yaml (or .yml) for respectively GEDCOM, JSON, GEDZIP, GEDCOM X JSON, XML, SQLite, NDJSON and YAML files. Output files can also be tables (.csv or .tsv), GraphViz graphs (.dot), Cypher scripts (.cypher) or RDF (.ttl for Turtle or .jsonld for JSON-LD).
 			
 		* <outputFilePath> [OPTIONAL]:
-			Relative path to the output file. Please make sure to use the file extensions .ged, .json and .protobuf for respectively GEDCOM, JSON and Protobuf files.
+			Relative path to the output file. Please make sure to use the file extensions .ged, .json, .gdz, .gedx.json, .xml, .sqlite, .ndjson and .yaml (or .yml) for respectively GEDCOM, JSON, GEDZIP, GEDCOM X JSON, XML, SQLite, NDJSON and YAML files. Output files can also be tables (.csv or .tsv), GraphViz graphs (.dot), Cypher scripts (.cypher) or RDF (.ttl for Turtle or .jsonld for JSON-LD).
 		`
 		log.Fatal(helpMessage)
 	default:
@@ -48,8 +243,14 @@ func checkMainArg() {
 	}
 }
 
-func checkFilepathArgs() {
-	if len(os.Args) < 4 {
+func checkInputFilepathArg(args []string) {
+	if len(args) < 1 {
+		log.Fatalln("please supply inputFilePath (use 'gedcom-parser help' for more information on usage)")
+	}
+}
+
+func checkFilepathArgs(args []string) {
+	if len(args) < 2 {
 		log.Fatalln("please supply inputFilePath and outputFilePath respectively (use 'gedcom-parser help' for more information on usage)")
 	}
 }
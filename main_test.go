@@ -17,7 +17,7 @@ func BenchmarkParseITISGedToJson(b *testing.B) {
 	if err != nil {
 		return
 	}
-	parse.Parse("examples/ITIS.ged", "test-output/ITIS.json")
+	parse.Parse("examples/ITIS.ged", "test-output/ITIS.json", nil, nil)
 	pprof.StopCPUProfile()
 
 	memFile, err := os.Create("mem-itis.prof")
@@ -40,7 +40,7 @@ func BenchmarkParseHPGedToJson(b *testing.B) {
 	if err != nil {
 		return
 	}
-	parse.Parse("examples/harry_potter.ged", "test-output/harry_potter.json")
+	parse.Parse("examples/harry_potter.ged", "test-output/harry_potter.json", nil, nil)
 	pprof.StopCPUProfile()
 
 	memFile, err := os.Create("mem-hp.prof")
@@ -64,7 +64,7 @@ func BenchmarkParseWikiGodsGedToJson(b *testing.B) {
 	if err != nil {
 		return
 	}
-	parse.Parse("examples/wikipedia_gods.ged", "test-output/wikipedia_gods.json")
+	parse.Parse("examples/wikipedia_gods.ged", "test-output/wikipedia_gods.json", nil, nil)
 	pprof.StopCPUProfile()
 
 	memFile, err := os.Create("mem-wg.prof")
@@ -0,0 +1,312 @@
+package parse
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// gedzipGedcomEntry is the name of the GEDCOM file at the root of every GEDZIP archive
+const gedzipGedcomEntry = "gedcom.ged"
+
+// directory of the entries of local media files packaged into GEDZIP archives
+const gedzipMediaDirectory = "media"
+
+// maximum sizes of an entry read from a GEDZIP archive and of all of its entries together,
+// which guard against archives decompressing to huge entries or to many large ones
+const (
+	maxGedzipEntrySize = 512 << 20
+	maxGedzipSize      = 1 << 30
+)
+
+// Gedzip holds the contents of a GEDZIP archive (.gdz): a gedcom along with the media files it references, by their entry names
+type Gedzip struct {
+	Gedcom *gedcomSpec.ConcurrencySafeGedcom
+	Media  map[string][]byte
+}
+
+// ReadGedzip reads a GEDZIP archive, holding a GEDCOM file named gedcom.ged and the media files it references, without validating it
+func ReadGedzip(inputReader io.ReaderAt, size int64) (*Gedzip, error) {
+	archive, err := zip.NewReader(inputReader, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GEDZIP archive with error: %s", err)
+	}
+
+	gedzip := &Gedzip{
+		Media: map[string][]byte{},
+	}
+	remainingSize := maxGedzipSize
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		content, err := readZipEntry(entry, remainingSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from GEDZIP archive with error: %s", entry.Name, err)
+		}
+		remainingSize -= len(content)
+		if entry.Name == gedzipGedcomEntry {
+			gedzip.Gedcom = ReadGedcom(bytes.NewReader(content))
+			continue
+		}
+		gedzip.Media[entry.Name] = content
+	}
+	if gedzip.Gedcom == nil {
+		return nil, fmt.Errorf("GEDZIP archive doesn't hold %s", gedzipGedcomEntry)
+	}
+	return gedzip, nil
+}
+
+// readZipEntry reads an entry of a GEDZIP archive, given the size the entries read so far leave of the size of the archive
+func readZipEntry(entry *zip.File, remainingSize int) ([]byte, error) {
+	reader, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	limit := maxGedzipEntrySize
+	if remainingSize < limit {
+		limit = remainingSize
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(reader, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxGedzipEntrySize {
+		return nil, fmt.Errorf("entry is larger than %d bytes", maxGedzipEntrySize)
+	}
+	if len(content) > remainingSize {
+		return nil, fmt.Errorf("entries are larger than %d bytes together", maxGedzipSize)
+	}
+	return content, nil
+}
+
+// UnresolvedMedia reports the multimedia files referring to local files that aren't in the archive
+func (z *Gedzip) UnresolvedMedia() []*gedcomSpec.Diagnostic {
+	var diagnostics []*gedcomSpec.Diagnostic
+	forEachMultimediaFile(&z.Gedcom.Gedcom, func(file *gedcomSpec.Gedcom_Multimedia_File, holderId string) {
+		entryName, local, isEntry := mediaReference(file.Reference)
+		if !local {
+			return
+		}
+		if _, ok := z.Media[entryName]; isEntry && ok {
+			return
+		}
+		diagnostics = append(diagnostics, gedzipMediaDiagnostic(holderId, "multimedia file %s isn't in the archive", file.Reference))
+	})
+	return diagnostics
+}
+
+// ParseGedzip parses a GEDZIP archive to the format of the output file.
+// The media files of the archive are kept when the output is a GEDZIP archive as well.
+func ParseGedzip(inputReader io.ReaderAt, size int64, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	gedzip, err := ReadGedzip(inputReader, size)
+	if err != nil {
+		return nil, err
+	}
+
+	logDiagnostics(gedzip.UnresolvedMedia())
+	logDiagnostics(gedzip.Gedcom.Validate(validateOptions))
+
+	return serialize(gedzip.Gedcom, to, exportOptions, gedzip.Media)
+}
+
+// WriteGedzip writes a gedcom as a GEDZIP archive along with the given media files.
+// The GEDCOM file in a GEDZIP archive is always written as GEDCOM 7.0.
+// When the export options package media, the local files referenced by multimedia files are added to the archive
+// and the references are rewritten to point to their entries.
+func WriteGedzip(gedcom *gedcomSpec.ConcurrencySafeGedcom, exportOptions *gedcomSpec.ExportOptions, media map[string][]byte) (*[]byte, error) {
+	options := gedcomSpec.ExportOptions{}
+	if exportOptions != nil {
+		options = *exportOptions
+	}
+	options.Version = gedcomSpec.GedcomVersion7
+
+	entries := map[string][]byte{}
+	for name, content := range media {
+		entries[name] = content
+	}
+	if options.PackageMedia {
+		packaged := gedcomSpec.NewConcurrencySafeGedcom()
+		proto.Merge(&packaged.Gedcom, &gedcom.Gedcom)
+		logDiagnostics(packageMedia(&packaged.Gedcom, entries, options.MediaDirectory))
+		gedcom = packaged
+	}
+
+	gedcomBuf, err := gedcom.ToSerializedGedcom(&options)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	archive := zip.NewWriter(buf)
+	names := []string{gedzipGedcomEntry}
+	entries[gedzipGedcomEntry] = gedcomBuf.Bytes()
+	var mediaNames []string
+	for name := range entries {
+		if name != gedzipGedcomEntry {
+			mediaNames = append(mediaNames, name)
+		}
+	}
+	sort.Strings(mediaNames)
+	for _, name := range append(names, mediaNames...) {
+		writer, err := archive.Create(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write %s to GEDZIP archive with error: %s", name, err)
+		}
+		_, err = writer.Write(entries[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to write %s to GEDZIP archive with error: %s", name, err)
+		}
+	}
+	err = archive.Close()
+	if err != nil {
+		return nil, err
+	}
+	gedzipBytes := buf.Bytes()
+	return &gedzipBytes, nil
+}
+
+// packageMedia adds the local files referenced by multimedia files that aren't in the archive yet to its entries.
+// Relative references keep their path in the archive, other local files are packaged into the media directory.
+func packageMedia(gedcom *gedcomSpec.Gedcom, entries map[string][]byte, mediaDirectory string) []*gedcomSpec.Diagnostic {
+	var diagnostics []*gedcomSpec.Diagnostic
+	forEachMultimediaFile(gedcom, func(file *gedcomSpec.Gedcom_Multimedia_File, holderId string) {
+		entryName, local, isEntry := mediaReference(file.Reference)
+		if !local {
+			return
+		}
+		if _, ok := entries[entryName]; isEntry && ok {
+			return
+		}
+
+		localPath := localMediaPath(file.Reference)
+		if isEntry {
+			localPath = filepath.Join(mediaDirectory, filepath.FromSlash(entryName))
+		}
+		content, err := ioutil.ReadFile(localPath)
+		if err != nil {
+			diagnostics = append(diagnostics, gedzipMediaDiagnostic(holderId, "failed to package multimedia file %s with error: %s", file.Reference, err))
+			return
+		}
+		if !isEntry {
+			entryName = uniqueEntryName(entries, path.Join(gedzipMediaDirectory, filepath.Base(localPath)))
+			file.Reference = (&url.URL{Path: entryName}).String()
+		}
+		entries[entryName] = content
+	})
+	return diagnostics
+}
+
+// mediaReference interprets the reference of a multimedia file, which is a URL or a local file path.
+// Local references are either relative, referring to an archive entry of which the name is returned, or absolute.
+func mediaReference(reference string) (entryName string, local bool, isEntry bool) {
+	if isWindowsPath(reference) {
+		return "", true, false
+	}
+	u, err := url.Parse(reference)
+	if err != nil {
+		return "", true, false
+	}
+	switch {
+	case u.Scheme == "file":
+		return "", true, false
+	case u.Scheme != "" || u.Host != "":
+		return "", false, false
+	case path.IsAbs(u.Path):
+		return "", true, false
+	}
+	entryName = path.Clean(u.Path)
+	if entryName == ".." || strings.HasPrefix(entryName, "../") {
+		return "", true, false
+	}
+	return entryName, true, true
+}
+
+// localMediaPath returns the path of the local file an absolute multimedia file reference refers to
+func localMediaPath(reference string) string {
+	if isWindowsPath(reference) {
+		return reference
+	}
+	if u, err := url.Parse(reference); err == nil {
+		return filepath.FromSlash(u.Path)
+	}
+	return reference
+}
+
+// isWindowsPath reports whether a reference is a Windows file path, e.g. C:\photos\photo.jpg
+func isWindowsPath(reference string) bool {
+	return len(reference) > 2 && reference[1] == ':' && (reference[2] == '\\' || reference[2] == '/') &&
+		('a' <= reference[0] && reference[0] <= 'z' || 'A' <= reference[0] && reference[0] <= 'Z')
+}
+
+// uniqueEntryName returns the given entry name, numbered if an entry with that name already exists
+func uniqueEntryName(entries map[string][]byte, name string) string {
+	if _, exists := entries[name]; !exists {
+		return name
+	}
+	extension := path.Ext(name)
+	for i := 2; ; i++ {
+		numbered := fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, extension), i, extension)
+		if _, exists := entries[numbered]; !exists {
+			return numbered
+		}
+	}
+}
+
+// forEachMultimediaFile calls fn for every multimedia file, of multimedia records as well as of the multimedia links
+// embedded in records and their events, along with the id of the record holding it
+func forEachMultimediaFile(gedcom *gedcomSpec.Gedcom, fn func(file *gedcomSpec.Gedcom_Multimedia_File, holderId string)) {
+	visitLinks := func(links []*gedcomSpec.Gedcom_MultimediaLink, holderId string) {
+		for _, link := range links {
+			for _, file := range link.Files {
+				fn(file, holderId)
+			}
+		}
+	}
+	visitEvents := func(events []*gedcomSpec.Gedcom_Individual_Event, holderId string) {
+		for _, e := range events {
+			visitLinks(e.MultimediaLinks, holderId)
+		}
+	}
+
+	for _, m := range gedcom.Multimedias {
+		for _, file := range m.Files {
+			fn(file, m.Id)
+		}
+	}
+	for _, i := range gedcom.Individuals {
+		visitLinks(i.MultimediaLinks, i.Id)
+		for _, eventType := range gedcomSpec.IndividualEventTypes {
+			visitEvents(*eventType.Events(i), i.Id)
+		}
+	}
+	for _, f := range gedcom.Families {
+		visitLinks(f.MultimediaLinks, f.Id)
+		visitEvents(f.MarriageEvents, f.Id)
+	}
+	for _, s := range gedcom.Sources {
+		visitLinks(s.MultimediaLinks, s.Id)
+	}
+	for _, s := range gedcom.Submitters {
+		visitLinks(s.MultimediaLinks, s.Id)
+	}
+}
+
+func gedzipMediaDiagnostic(holderId string, format string, args ...interface{}) *gedcomSpec.Diagnostic {
+	return &gedcomSpec.Diagnostic{
+		Rule:     "gedzip-media",
+		Severity: gedcomSpec.SeverityWarning,
+		XRefId:   holderId,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
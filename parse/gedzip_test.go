@@ -0,0 +1,217 @@
+package parse
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+)
+
+func TestWriteAndReadGedzip(t *testing.T) {
+	mediaDirectory, err := ioutil.TempDir("", "gedzip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(mediaDirectory)
+	absolutePath := filepath.Join(mediaDirectory, "portrait.jpg")
+	for path, content := range map[string]string{
+		filepath.Join(mediaDirectory, "photos", "photo one.jpg"): "photo",
+		absolutePath: "portrait",
+	} {
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gedcom := ReadGedcom(strings.NewReader(strings.Join([]string{
+		"0 HEAD",
+		"1 GEDC",
+		"2 VERS 5.5.1",
+		"0 @M1@ OBJE",
+		"1 FILE photos/photo%20one.jpg",
+		"2 FORM jpg",
+		"0 @M2@ OBJE",
+		"1 FILE " + absolutePath,
+		"2 FORM jpg",
+		"0 @M3@ OBJE",
+		"1 FILE https://example.com/photo.jpg",
+		"2 FORM jpg",
+		"0 @M4@ OBJE",
+		"1 FILE missing.jpg",
+		"2 FORM jpg",
+		"0 TRLR",
+	}, "\n")))
+
+	output, err := serialize(gedcom, "out.gdz", &gedcomSpec.ExportOptions{PackageMedia: true, MediaDirectory: mediaDirectory}, map[string][]byte{"archived.jpg": []byte("archived")})
+	if err != nil {
+		t.Fatalf("failed to write GEDZIP archive with error: %s", err)
+	}
+	if multimediaReference(gedcom, "@M2@") != absolutePath {
+		t.Errorf("packaging media changes the written gedcom")
+	}
+
+	gedzip, err := ReadGedzip(bytes.NewReader(*output), int64(len(*output)))
+	if err != nil {
+		t.Fatalf("failed to read GEDZIP archive with error: %s", err)
+	}
+	if !gedzip.Gedcom.IsGedcom7() {
+		t.Errorf("GEDZIP archive doesn't hold GEDCOM 7.0")
+	}
+	expectedMedia := map[string]string{
+		"photos/photo one.jpg": "photo",
+		"media/portrait.jpg":   "portrait",
+		"archived.jpg":         "archived",
+	}
+	if len(gedzip.Media) != len(expectedMedia) {
+		t.Errorf("expected media entries %v, found %d entries", expectedMedia, len(gedzip.Media))
+	}
+	for name, content := range expectedMedia {
+		if string(gedzip.Media[name]) != content {
+			t.Errorf("expected entry %s to hold %q, found %q", name, content, gedzip.Media[name])
+		}
+	}
+	if reference := multimediaReference(gedzip.Gedcom, "@M2@"); reference != "media/portrait.jpg" {
+		t.Errorf("expected packaged reference media/portrait.jpg, found %s", reference)
+	}
+
+	unresolved := gedzip.UnresolvedMedia()
+	if len(unresolved) != 1 || unresolved[0].XRefId != "@M4@" {
+		t.Errorf("expected only @M4@ to be unresolved, found %v", unresolved)
+	}
+}
+
+func TestEmbeddedMultimediaLinks(t *testing.T) {
+	mediaDirectory, err := ioutil.TempDir("", "gedzip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(mediaDirectory)
+	absolutePath := filepath.Join(mediaDirectory, "portrait.jpg")
+	if err := ioutil.WriteFile(absolutePath, []byte("portrait"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	scanPath := filepath.Join(mediaDirectory, "scan.jpg")
+	if err := ioutil.WriteFile(scanPath, []byte("scan"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	gedcom := ReadGedcom(strings.NewReader(strings.Join([]string{
+		"0 HEAD",
+		"1 GEDC",
+		"2 VERS 5.5.1",
+		"0 @I1@ INDI",
+		"1 OBJE",
+		"2 FILE " + absolutePath,
+		"3 FORM jpg",
+		"1 BIRT",
+		"2 OBJE",
+		"3 FILE birth.jpg",
+		"4 FORM jpg",
+		"0 @F1@ FAM",
+		"1 MARR",
+		"2 OBJE",
+		"3 FILE wedding.jpg",
+		"4 FORM jpg",
+		"0 @S1@ SOUR",
+		"1 OBJE",
+		"2 FILE " + scanPath,
+		"3 FORM jpg",
+		"0 TRLR",
+	}, "\n")))
+
+	entries := map[string][]byte{"wedding.jpg": []byte("wedding")}
+	diagnostics := packageMedia(&gedcom.Gedcom, entries, mediaDirectory)
+	if len(diagnostics) != 1 || diagnostics[0].XRefId != "@I1@" {
+		t.Errorf("expected only the birth photo of @I1@ to fail to be packaged, found %v", diagnostics)
+	}
+	if string(entries["media/portrait.jpg"]) != "portrait" {
+		t.Errorf("expected the portrait of @I1@ to be packaged, found entries %v", entries)
+	}
+	if reference := gedcom.Individuals[0].MultimediaLinks[0].Files[0].Reference; reference != "media/portrait.jpg" {
+		t.Errorf("expected packaged reference media/portrait.jpg, found %s", reference)
+	}
+	if reference := gedcom.Sources[0].MultimediaLinks[0].Files[0].Reference; string(entries["media/scan.jpg"]) != "scan" || reference != "media/scan.jpg" {
+		t.Errorf("expected the scan of @S1@ to be packaged as media/scan.jpg, found reference %s", reference)
+	}
+
+	unresolved := (&Gedzip{Gedcom: gedcom, Media: entries}).UnresolvedMedia()
+	if len(unresolved) != 1 || unresolved[0].XRefId != "@I1@" || !strings.Contains(unresolved[0].Message, "birth.jpg") {
+		t.Errorf("expected only the birth photo of @I1@ to be unresolved, found %v", unresolved)
+	}
+}
+
+func multimediaReference(gedcom *gedcomSpec.ConcurrencySafeGedcom, id string) string {
+	for _, m := range gedcom.Multimedias {
+		if m.Id == id {
+			return m.Files[0].Reference
+		}
+	}
+	return ""
+}
+
+func TestMediaReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		entryName string
+		local     bool
+		isEntry   bool
+	}{
+		{"photo.jpg", "photo.jpg", true, true},
+		{"photos/./photo%20one.jpg", "photos/photo one.jpg", true, true},
+		{"../photo.jpg", "", true, false},
+		{"/home/photo.jpg", "", true, false},
+		{"file:///home/photo.jpg", "", true, false},
+		{`C:\photos\photo.jpg`, "", true, false},
+		{"https://example.com/photo.jpg", "", false, false},
+	}
+	for _, test := range tests {
+		entryName, local, isEntry := mediaReference(test.reference)
+		if entryName != test.entryName || local != test.local || isEntry != test.isEntry {
+			t.Errorf("mediaReference(%q) = %q, %t, %t, expected %q, %t, %t", test.reference, entryName, local, isEntry, test.entryName, test.local, test.isEntry)
+		}
+	}
+}
+
+func TestReadZipEntryLimitsTheSizeOfTheArchive(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	entryWriter, err := writer.Create("media/scan.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := entryWriter.Write([]byte("0123456789")); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		remainingSize int
+		expectError   bool
+	}{
+		{maxGedzipSize, false},
+		{10, false},
+		{9, true},
+	} {
+		content, err := readZipEntry(archive.File[0], c.remainingSize)
+		if c.expectError && err == nil {
+			t.Errorf("expected reading a 10 byte entry with %d bytes remaining to fail, got %q", c.remainingSize, content)
+		}
+		if !c.expectError && (err != nil || string(content) != "0123456789") {
+			t.Errorf("expected reading a 10 byte entry with %d bytes remaining to succeed, got %q and error %v", c.remainingSize, content, err)
+		}
+	}
+}
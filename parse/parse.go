@@ -6,22 +6,28 @@ import (
 	"encoding/json"
 	"fmt"
 	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+	"github.com/jochenboesmans/gedcom-parser/sqlite"
+	"google.golang.org/protobuf/proto"
 	"io"
 	"io/ioutil"
 	"log"
 	"math"
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 /*
 Parse local files representing a gedcom structure to a different format representing the same structure.
 
-Example usage: Parse("./familytree.ged", "./familytree.json") would parse the GEDCOM file at ./familytree.ged into a json structure and put the result in a file at ./familytree.json.
+Example usage: Parse("./familytree.ged", "./familytree.json", nil, nil) would parse the GEDCOM file at ./familytree.ged into a json structure and put the result in a file at ./familytree.json.
+The validate options determine how issues in the input are repaired, nil options use the defaults.
+The export options determine which data ends up in the output file, nil options export all data.
 */
-func Parse(inputFilePath string, outputFilePath string) {
+func Parse(inputFilePath string, outputFilePath string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) {
 	beginTime := time.Now()
 
 	input, err := ioutil.ReadFile(inputFilePath)
@@ -29,22 +35,93 @@ func Parse(inputFilePath string, outputFilePath string) {
 		log.Fatalf("failed to read from input file at %s with error: %s\n", inputFilePath, err)
 	}
 
+	if exportOptions != nil && exportOptions.PackageMedia && exportOptions.MediaDirectory == "" {
+		withMediaDirectory := *exportOptions
+		withMediaDirectory.MediaDirectory = filepath.Dir(inputFilePath)
+		exportOptions = &withMediaDirectory
+	}
+
+	if streamsNDJSON(inputFilePath, outputFilePath, validateOptions, exportOptions) {
+		err = streamNDJSON(input, outputFilePath, validateOptions)
+		if err != nil {
+			log.Fatalf("failed to write to output file at %s with error: %s\n", outputFilePath, err)
+		}
+		secondsSinceBeginTime := float64(time.Since(beginTime)) * math.Pow10(-9)
+		log.Printf("successfully parsed file at %s to %s. total time taken: %f seconds\n", inputFilePath, outputFilePath, secondsSinceBeginTime)
+		return
+	}
+
+	// outputs other than a single serialized file, or written to their file as they're serialized, are written from the read gedcom
+	if extension := FileExtension(outputFilePath, exportOptions); extension == ".csv" || extension == ".tsv" || extension == ".sqlite" || extension == ".ndjson" {
+		gedcom, err := read(input, inputFilePath, exportOptions)
+		if err != nil {
+			log.Fatalf("failed to parse file at %s with error: %s\n", inputFilePath, err)
+		}
+
+		logDiagnostics(gedcom.Validate(validateOptions))
+
+		switch extension {
+		case ".sqlite":
+			err = sqlite.Write(gedcom, outputFilePath, exportOptions)
+		case ".ndjson":
+			err = writeNDJSON(gedcom, outputFilePath, exportOptions)
+		default:
+			err = writeTables(gedcom, outputFilePath, exportOptions)
+		}
+		if err != nil {
+			log.Fatalf("failed to write to output file at %s with error: %s\n", outputFilePath, err)
+		}
+		secondsSinceBeginTime := float64(time.Since(beginTime)) * math.Pow10(-9)
+		log.Printf("successfully parsed file at %s to %s. total time taken: %f seconds\n", inputFilePath, outputFilePath, secondsSinceBeginTime)
+		return
+	}
+
 	var output *[]byte
 	inputReader := bytes.NewReader(input)
 
-	switch filepath.Ext(inputFilePath) {
+	switch FileExtension(inputFilePath, exportOptions) {
 	case ".ged":
-		output, err = ParseGedcom(inputReader, outputFilePath)
+		output, err = ParseGedcom(inputReader, outputFilePath, validateOptions, exportOptions)
 		if err != nil {
 			log.Fatalf("failed to parse GEDCOM file at %s with error: %s\n", inputFilePath, err)
 		}
 	case ".json":
-		output, err = ParseJSON(inputReader)
+		output, err = ParseJSON(inputReader, outputFilePath, validateOptions, exportOptions)
 		if err != nil {
 			log.Fatalf("failed to parse JSON file at %s with error: %s\n", inputFilePath, err)
 		}
+	case ".gdz":
+		output, err = ParseGedzip(inputReader, int64(len(input)), outputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			log.Fatalf("failed to parse GEDZIP file at %s with error: %s\n", inputFilePath, err)
+		}
+	case GedcomXExtension:
+		output, err = ParseGedcomX(inputReader, outputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			log.Fatalf("failed to parse GEDCOM X file at %s with error: %s\n", inputFilePath, err)
+		}
+	case ".xml":
+		output, err = ParseXML(inputReader, outputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			log.Fatalf("failed to parse XML file at %s with error: %s\n", inputFilePath, err)
+		}
+	case ".sqlite":
+		output, err = ParseSQLite(inputFilePath, outputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			log.Fatalf("failed to parse SQLite database at %s with error: %s\n", inputFilePath, err)
+		}
+	case ".ndjson":
+		output, err = ParseNDJSON(inputReader, outputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			log.Fatalf("failed to parse NDJSON file at %s with error: %s\n", inputFilePath, err)
+		}
+	case ".yaml":
+		output, err = ParseYAML(inputReader, outputFilePath, validateOptions, exportOptions)
+		if err != nil {
+			log.Fatalf("failed to parse YAML file at %s with error: %s\n", inputFilePath, err)
+		}
 	default:
-		log.Fatalf("failed to match input file (at %s) extension to: .ged|.json\n", inputFilePath)
+		log.Fatalf("failed to match input file (at %s) extension to: .ged|.json|.gdz|.gedx.json|.xml|.sqlite|.ndjson|.yaml|.yml\n", inputFilePath)
 	}
 
 	err = ioutil.WriteFile(outputFilePath, *output, 0600)
@@ -56,35 +133,338 @@ func Parse(inputFilePath string, outputFilePath string) {
 	log.Printf("successfully parsed file at %s to %s. total time taken: %f seconds\n", inputFilePath, outputFilePath, secondsSinceBeginTime)
 }
 
+// Read reads a local file representing a gedcom structure, without validating it
+func Read(inputFilePath string) (*gedcomSpec.ConcurrencySafeGedcom, error) {
+	input, err := ioutil.ReadFile(inputFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from input file at %s with error: %s", inputFilePath, err)
+	}
+	return read(input, inputFilePath, nil)
+}
+
+// read interprets the contents of a file representing a gedcom structure in the format its extension matches
+func read(input []byte, inputFilePath string, exportOptions *gedcomSpec.ExportOptions) (*gedcomSpec.ConcurrencySafeGedcom, error) {
+	inputReader := bytes.NewReader(input)
+
+	switch FileExtension(inputFilePath, exportOptions) {
+	case ".ged":
+		return ReadGedcom(inputReader), nil
+	case ".json":
+		return ReadJSON(inputReader)
+	case ".gdz":
+		gedzip, err := ReadGedzip(inputReader, int64(len(input)))
+		if err != nil {
+			return nil, err
+		}
+		return gedzip.Gedcom, nil
+	case GedcomXExtension:
+		return ReadGedcomX(inputReader)
+	case ".xml":
+		return gedcomSpec.InterpretXML(inputReader)
+	case ".sqlite":
+		return sqlite.Read(inputFilePath)
+	case ".ndjson":
+		return gedcomSpec.InterpretNDJSON(inputReader)
+	case ".yaml":
+		return gedcomSpec.InterpretYAML(inputReader)
+	}
+	return nil, fmt.Errorf("failed to match input file (at %s) extension to: .ged|.json|.gdz|.gedx.json|.xml|.sqlite|.ndjson|.yaml|.yml", inputFilePath)
+}
+
+// writeNDJSON writes the records of a gedcom to an NDJSON file as they're serialized
+func writeNDJSON(gedcom *gedcomSpec.ConcurrencySafeGedcom, outputFilePath string, exportOptions *gedcomSpec.ExportOptions) error {
+	output, err := os.OpenFile(outputFilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	err = gedcom.WriteNDJSON(output, exportOptions)
+	if err != nil {
+		_ = output.Close()
+		return err
+	}
+	return output.Close()
+}
+
+// streamsNDJSON reports whether the records of a GEDCOM file are written to NDJSON as soon as they're interpreted,
+// which is the case unless the options need a gedcom holding the whole tree (see gedcomSpec.Streamable)
+func streamsNDJSON(inputFilePath string, outputFilePath string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) bool {
+	return FileExtension(inputFilePath, exportOptions) == ".ged" && FileExtension(outputFilePath, exportOptions) == ".ndjson" &&
+		gedcomSpec.Streamable(validateOptions, exportOptions)
+}
+
+// streamNDJSON writes the records of GEDCOM input to an NDJSON file as they're interpreted and validated.
+// The header is interpreted before any other record, so it's still written first.
+func streamNDJSON(input []byte, outputFilePath string, validateOptions *gedcomSpec.ValidateOptions) error {
+	output, err := os.OpenFile(outputFilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	ndjsonWriter := gedcomSpec.NewNDJSONWriter(output)
+	gedcom, err := StreamGedcom(bytes.NewReader(input), validateOptions, func(recordType string, record proto.Message) {
+		_ = ndjsonWriter.WriteRecord(recordType, record)
+	})
+	if err == nil {
+		logDiagnostics(gedcom.Validate(validateOptions))
+		err = ndjsonWriter.Flush()
+	}
+	if err != nil {
+		_ = output.Close()
+		return err
+	}
+	return output.Close()
+}
+
+// writeTables writes a table file for each of the tables of a tabular export, named after the output file and the table,
+// e.g. familytree.individuals.csv for an output file at familytree.csv. Tab-separated files (.tsv) are delimited by tabs by default.
+func writeTables(gedcom *gedcomSpec.ConcurrencySafeGedcom, outputFilePath string, exportOptions *gedcomSpec.ExportOptions) error {
+	if filepath.Ext(outputFilePath) == ".tsv" && (exportOptions == nil || exportOptions.CSVDelimiter == 0) {
+		withTabs := gedcomSpec.ExportOptions{}
+		if exportOptions != nil {
+			withTabs = *exportOptions
+		}
+		withTabs.CSVDelimiter = '\t'
+		exportOptions = &withTabs
+	}
+	tables, err := gedcom.ToCSV(exportOptions)
+	if err != nil {
+		return err
+	}
+	for name, table := range tables {
+		err = ioutil.WriteFile(TableFilePath(outputFilePath, name), *table, 0600)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TableFilePath returns the path of the file of a table of a tabular export to the given output file
+func TableFilePath(outputFilePath string, tableName string) string {
+	extension := filepath.Ext(outputFilePath)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(outputFilePath, extension), tableName, extension)
+}
+
+// extension of GEDCOM X JSON files
+const GedcomXExtension = ".gedx.json"
+
+// FileExtension returns the extension determining the format of a file.
+// JSON files are GEDCOM X JSON when they're named *.gedx.json or the export options select GEDCOM X as JSON format.
+// YAML files can be named *.yaml or *.yml.
+func FileExtension(filePath string, exportOptions *gedcomSpec.ExportOptions) string {
+	extension := filepath.Ext(filePath)
+	if extension == ".yml" {
+		return ".yaml"
+	}
+	if extension == ".json" && (strings.HasSuffix(filePath, GedcomXExtension) || exportOptions != nil && exportOptions.JSONFormat == gedcomSpec.JSONFormatGedcomX) {
+		return GedcomXExtension
+	}
+	return extension
+}
+
+// CheckConformance checks a GEDCOM file against the GEDCOM 5.5.1 grammar
+func CheckConformance(inputReader io.Reader) (*gedcomSpec.ConformanceReport, error) {
+	var lines []string
+	err := readLines(inputReader, func(line string) {
+		lines = append(lines, line)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gedcomSpec.CheckConformance(lines), nil
+}
+
+// readLines calls fn for every line of the input, without the line ending.
+// Unlike bufio.Scanner, lines aren't limited in length, so over-long lines can be reported rather than ending the read.
+func readLines(inputReader io.Reader, fn func(line string)) error {
+	reader := bufio.NewReader(inputReader)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			fn(strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func trimBOM(line string) string {
 	return strings.TrimPrefix(line, "\uFEFF")
 }
 
-func ParseGedcom(inputReader io.Reader, to string) (*[]byte, error) {
-	fileScanner := bufio.NewScanner(inputReader)
-	fileScanner.Split(bufio.ScanLines)
+func ParseGedcom(inputReader io.Reader, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	gedcom := ReadGedcom(inputReader)
 
-	recordLines := []*gedcomSpec.Line{}
-	waitGroup := &sync.WaitGroup{}
+	logDiagnostics(gedcom.Validate(validateOptions))
+
+	return serialize(gedcom, to, exportOptions, nil)
+}
+
+func ParseJSON(inputReader io.Reader, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	concSafeGedcom, err := ReadJSON(inputReader)
+	if err != nil {
+		return nil, err
+	}
+
+	logDiagnostics(concSafeGedcom.Validate(validateOptions))
+
+	return serialize(concSafeGedcom, to, exportOptions, nil)
+}
+
+// ParseXML parses XML with the schema of gedcom.xsd to the format of the output file
+func ParseXML(inputReader io.Reader, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	concSafeGedcom, err := gedcomSpec.InterpretXML(inputReader)
+	if err != nil {
+		return nil, err
+	}
+
+	logDiagnostics(concSafeGedcom.Validate(validateOptions))
+
+	return serialize(concSafeGedcom, to, exportOptions, nil)
+}
+
+// ParseYAML parses YAML mirroring the json representation of a gedcom to the format of the output file
+func ParseYAML(inputReader io.Reader, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	concSafeGedcom, err := gedcomSpec.InterpretYAML(inputReader)
+	if err != nil {
+		return nil, err
+	}
+
+	logDiagnostics(concSafeGedcom.Validate(validateOptions))
+
+	return serialize(concSafeGedcom, to, exportOptions, nil)
+}
+
+// ParseNDJSON parses NDJSON with a record per line to the format of the output file
+func ParseNDJSON(inputReader io.Reader, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	concSafeGedcom, err := gedcomSpec.InterpretNDJSON(inputReader)
+	if err != nil {
+		return nil, err
+	}
+
+	logDiagnostics(concSafeGedcom.Validate(validateOptions))
+
+	return serialize(concSafeGedcom, to, exportOptions, nil)
+}
+
+// ParseSQLite parses a SQLite database with the schema sqlite.Write creates to the format of the output file
+func ParseSQLite(inputFilePath string, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	concSafeGedcom, err := sqlite.Read(inputFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	logDiagnostics(concSafeGedcom.Validate(validateOptions))
+
+	return serialize(concSafeGedcom, to, exportOptions, nil)
+}
+
+// ParseGedcomX parses GEDCOM X JSON to the format of the output file
+func ParseGedcomX(inputReader io.Reader, to string, validateOptions *gedcomSpec.ValidateOptions, exportOptions *gedcomSpec.ExportOptions) (*[]byte, error) {
+	concSafeGedcom, err := ReadGedcomX(inputReader)
+	if err != nil {
+		return nil, err
+	}
 
+	logDiagnostics(concSafeGedcom.Validate(validateOptions))
+
+	return serialize(concSafeGedcom, to, exportOptions, nil)
+}
+
+// serialize writes a gedcom in the format matching the extension of the output file,
+// media files only end up in the output when it's a GEDZIP archive
+func serialize(gedcom *gedcomSpec.ConcurrencySafeGedcom, to string, exportOptions *gedcomSpec.ExportOptions, media map[string][]byte) (*[]byte, error) {
+	switch FileExtension(to, exportOptions) {
+	case ".json":
+		return gedcom.ToJson(exportOptions)
+	case GedcomXExtension:
+		return gedcom.ToGedcomX(exportOptions)
+	case ".xml":
+		return gedcom.ToXML(exportOptions)
+	case ".ged":
+		gedcomBuf, err := gedcom.ToSerializedGedcom(exportOptions)
+		if err != nil {
+			return nil, err
+		}
+		gedcomBytes := gedcomBuf.Bytes()
+		return &gedcomBytes, nil
+	case ".gdz":
+		return WriteGedzip(gedcom, exportOptions, media)
+	case ".dot":
+		return gedcom.ToDot(exportOptions)
+	case ".cypher":
+		return gedcom.ToCypher(exportOptions)
+	case ".ttl":
+		return gedcom.ToTurtle(exportOptions)
+	case ".jsonld":
+		return gedcom.ToJSONLD(exportOptions)
+	case ".ndjson":
+		return gedcom.ToNDJSON(exportOptions)
+	case ".yaml":
+		return gedcom.ToYAML(exportOptions)
+	}
+
+	return nil, fmt.Errorf("failed to match output file extension to: %s", ".json|.ged|.gdz|.gedx.json|.xml|.dot|.cypher|.ttl|.jsonld|.ndjson|.yaml|.yml")
+}
+
+// ReadGedcom interprets GEDCOM lines into a gedcom structure, without validating it
+func ReadGedcom(inputReader io.Reader) *gedcomSpec.ConcurrencySafeGedcom {
 	gedcom := gedcomSpec.NewConcurrencySafeGedcom()
+	readGedcom(inputReader, gedcom)
+	return gedcom
+}
+
+/*
+StreamGedcom interprets GEDCOM lines, handing every record to the handler as soon as it's interpreted and validated under
+the given options instead of holding the records in a gedcom structure (see gedcomSpec.NewStreamingGedcom).
+The input is read twice: first for the ids of all records, which pointers are checked against, then for the records.
+Validate reports the issues found in the records of the returned gedcom, whatever options it's given.
+*/
+func StreamGedcom(inputReader io.ReadSeeker, validateOptions *gedcomSpec.ValidateOptions, handler gedcomSpec.RecordHandler) (*gedcomSpec.ConcurrencySafeGedcom, error) {
+	gedcom := gedcomSpec.NewStreamingGedcom(validateOptions, handler)
+	err := readLines(inputReader, func(line string) {
+		recordLine := gedcomSpec.NewLine(line)
+		if level, err := recordLine.Level(); err == nil && level == 0 {
+			gedcom.AddRecordId(recordLine)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = inputReader.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	readGedcom(inputReader, gedcom)
+	return gedcom, nil
+}
+
+// readGedcom interprets GEDCOM lines into a gedcom, interpreting every record concurrently once it's fully read
+func readGedcom(inputReader io.Reader, gedcom *gedcomSpec.ConcurrencySafeGedcom) {
+	recordLines := []*gedcomSpec.Line{}
+	waitGroup := &sync.WaitGroup{}
 
 	headerInterpreted := false
 
 	i := 0
-	for fileScanner.Scan() {
+	err := readLines(inputReader, func(readLine string) {
 		line := ""
-		readLine := fileScanner.Text()
 		if i == 0 {
 			line = trimBOM(readLine)
 		} else {
 			line = readLine
 		}
+		if headerInterpreted && gedcom.IsGedcom7() && !utf8.ValidString(line) {
+			gedcom.ReportInvalidUTF8(i + 1)
+		}
 		gedcomLine := gedcomSpec.NewLine(line)
 
 		level, err := gedcomLine.Level()
 		if err != nil {
-			continue
+			return
 		}
 
 		// interpret record once it's fully read
@@ -102,42 +482,40 @@ func ParseGedcom(inputReader io.Reader, to string) (*[]byte, error) {
 		}
 		recordLines = append(recordLines, gedcomLine)
 		i++
+	})
+	if err != nil {
+		log.Printf("failed to read line %d with error: %s\n", i+1, err)
 	}
 
 	waitGroup.Wait()
-
-	gedcom.Validate()
-
-	switch filepath.Ext(to) {
-	case ".json":
-		return gedcom.ToJson()
-	}
-
-	return nil, fmt.Errorf("failed to match output file extension to: %s", ".json|.protobuf")
 }
 
-func ParseJSON(inputReader io.Reader) (*[]byte, error) {
-	gedcom := gedcomSpec.Gedcom{}
-
+// ReadJSON unmarshals a JSON representation of a gedcom structure, without validating it
+func ReadJSON(inputReader io.Reader) (*gedcomSpec.ConcurrencySafeGedcom, error) {
 	gedcomJson, err := ioutil.ReadAll(inputReader)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(gedcomJson, &gedcom)
+	concSafeGedcom := gedcomSpec.NewConcurrencySafeGedcom()
+	err = json.Unmarshal(gedcomJson, &concSafeGedcom.Gedcom)
 	if err != nil {
 		return nil, err
 	}
+	return concSafeGedcom, nil
+}
 
-	concSafeGedcom := gedcomSpec.NewConcurrencySafeGedcom()
-	concSafeGedcom.Gedcom = gedcom
-
-	concSafeGedcom.Validate()
-
-	gedcomBuf, err := concSafeGedcom.ToSerializedGedcom()
+// ReadGedcomX interprets GEDCOM X JSON into a gedcom structure, without validating it
+func ReadGedcomX(inputReader io.Reader) (*gedcomSpec.ConcurrencySafeGedcom, error) {
+	gedcomXJson, err := ioutil.ReadAll(inputReader)
 	if err != nil {
 		return nil, err
 	}
-	gedcomBytes := gedcomBuf.Bytes()
-	return &gedcomBytes, nil
+	return gedcomSpec.InterpretGedcomX(gedcomXJson)
+}
+
+func logDiagnostics(diagnostics []*gedcomSpec.Diagnostic) {
+	for _, d := range diagnostics {
+		log.Println(d)
+	}
 }
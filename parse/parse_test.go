@@ -0,0 +1,214 @@
+package parse
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckConformanceOfOverlongLines(t *testing.T) {
+	gedcomLines := strings.Join([]string{
+		"0 HEAD",
+		"1 SOUR test",
+		"1 SUBM @U1@",
+		"1 GEDC",
+		"2 VERS 5.5.1",
+		"2 FORM LINEAGE-LINKED",
+		"1 CHAR UTF-8",
+		"0 @U1@ SUBM",
+		"1 NAME Submitter",
+		"0 @N1@ NOTE " + strings.Repeat("x", 100000),
+		"0 TRLR",
+	}, "\r\n")
+	report, err := CheckConformance(strings.NewReader(gedcomLines))
+	if err != nil {
+		t.Fatalf("failed to check conformance with error: %s", err)
+	}
+	if report.Lines != 11 {
+		t.Errorf("expected 11 lines to be checked, got %d", report.Lines)
+	}
+	found := false
+	for _, d := range report.Diagnostics {
+		found = found || d.Rule == "line-length" && d.Line == 10
+	}
+	if !found || report.Conforming {
+		t.Errorf("expected a line-length diagnostic for line 10, got %v", report.Diagnostics)
+	}
+
+	gedcom := ReadGedcom(strings.NewReader(gedcomLines))
+	if len(gedcom.Notes) != 1 || len(gedcom.Notes[0].SubmitterText) != 100000 {
+		t.Errorf("expected the over-long note to be read, got %v", gedcom.Notes)
+	}
+}
+
+func TestReadGedcom7WithInvalidUTF8(t *testing.T) {
+	gedcom := ReadGedcom(strings.NewReader(strings.Join([]string{
+		"0 HEAD",
+		"1 GEDC",
+		"2 VERS 7.0",
+		"0 @I1@ INDI",
+		"1 NAME Fran\xe7ois /Dupont/",
+		"0 TRLR",
+	}, "\n")))
+	var encodingDiagnostics []*gedcomSpec.Diagnostic
+	for _, d := range gedcom.Validate(nil) {
+		if d.Rule == "encoding" {
+			encodingDiagnostics = append(encodingDiagnostics, d)
+		}
+	}
+	if len(encodingDiagnostics) != 1 || encodingDiagnostics[0].Line != 5 || encodingDiagnostics[0].Severity != gedcomSpec.SeverityError {
+		t.Errorf("expected an encoding error for line 5, got %v", encodingDiagnostics)
+	}
+}
+
+func TestStreamNDJSON(t *testing.T) {
+	outputDirectory, err := ioutil.TempDir("", "ndjson")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outputDirectory)
+
+	lines := []string{
+		"0 HEAD",
+		"1 CHAR UTF-8",
+		"1 SUBM @U1@",
+		"0 @I1@ INDI",
+		"1 NAME Harry /Potter/",
+		"1 FAMC @F1@",
+		"1 FAMS @F2@",
+		"0 @I2@ INDI",
+		"1 NAME James /Potter/",
+		"1 FAMS @F1@",
+		"1 FAMC @F3@",
+		"0 @F1@ FAM",
+		"1 HUSB @I2@",
+		"1 CHIL @I1@",
+		"0 @F3@ FAM",
+		"1 HUSB @I1@",
+		"1 CHIL @I2@",
+	}
+	input := []byte(strings.Join(append(lines, "0 TRLR"), "\n"))
+	for _, policy := range []gedcomSpec.DanglingPointerPolicy{gedcomSpec.DanglingPointersRemove, gedcomSpec.DanglingPointersReport, gedcomSpec.DanglingPointersPlaceholder} {
+		validateOptions := &gedcomSpec.ValidateOptions{DanglingPointers: policy}
+		outputFilePath := filepath.Join(outputDirectory, "tree.ndjson")
+		if err := streamNDJSON(input, outputFilePath, validateOptions); err != nil {
+			t.Fatalf("failed to stream NDJSON with error: %s", err)
+		}
+		output, err := ioutil.ReadFile(outputFilePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(output), `{"type":"header"`) {
+			t.Errorf("expected the header to be streamed first, got %s", output)
+		}
+		streamed, err := gedcomSpec.InterpretNDJSON(bytes.NewReader(output))
+		if err != nil {
+			t.Fatalf("failed to interpret streamed NDJSON with error: %s", err)
+		}
+
+		read := ReadGedcom(bytes.NewReader(input))
+		read.Validate(validateOptions)
+		for id, individual := range read.IndividualsByIds() {
+			if !proto.Equal(individual, streamed.IndividualsByIds()[id]) {
+				t.Errorf("expected streamed individual %s under policy %q to be %v, got %v", id, policy, individual, streamed.IndividualsByIds()[id])
+			}
+		}
+		for id, family := range read.FamiliesByIds() {
+			if !proto.Equal(family, streamed.FamiliesByIds()[id]) {
+				t.Errorf("expected streamed family %s under policy %q to be %v, got %v", id, policy, family, streamed.FamiliesByIds()[id])
+			}
+		}
+		if len(streamed.Individuals) != 2 || len(streamed.Families) != len(read.Families) || len(streamed.Submitters) != len(read.Submitters) ||
+			!proto.Equal(read.Header, streamed.Header) {
+			t.Errorf("expected the streamed records under policy %q to be the validated records %v, got %v", policy, &read.Gedcom, &streamed.Gedcom)
+		}
+	}
+
+	// duplicate ids are reported but left as is, as streamed records may already have been written
+	withDuplicate := []byte(strings.Join(append(lines, "0 @I1@ INDI", "1 NAME Harry /Duplicate/", "0 TRLR"), "\n"))
+	gedcom, err := StreamGedcom(bytes.NewReader(withDuplicate), nil, func(string, proto.Message) {})
+	if err != nil {
+		t.Fatalf("failed to stream GEDCOM with error: %s", err)
+	}
+	if len(gedcom.Individuals) != 0 || len(gedcom.Families) != 2 || gedcom.Header == nil {
+		t.Errorf("expected a streaming gedcom to only hold the header and families, got %v", &gedcom.Gedcom)
+	}
+	rules := map[string]int{}
+	for _, d := range gedcom.Validate(nil) {
+		rules[d.Rule]++
+	}
+	if rules["duplicate-xref"] != 1 || rules["dangling-pointer"] != 2 || rules["ancestry-cycle"] != 1 {
+		t.Errorf("expected a duplicate id, 2 dangling pointers and an ancestry cycle to be reported, got %v", rules)
+	}
+}
+
+func TestStreamsNDJSON(t *testing.T) {
+	for _, c := range []struct {
+		inputFilePath   string
+		outputFilePath  string
+		validateOptions *gedcomSpec.ValidateOptions
+		exportOptions   *gedcomSpec.ExportOptions
+		expected        bool
+	}{
+		{"tree.ged", "tree.ndjson", nil, nil, true},
+		{"tree.ged", "tree.ndjson", &gedcomSpec.ValidateOptions{DanglingPointers: gedcomSpec.DanglingPointersPlaceholder}, nil, true},
+		{"tree.ged", "tree.ndjson", nil, &gedcomSpec.ExportOptions{Version: gedcomSpec.GedcomVersion7}, true},
+		{"tree.json", "tree.ndjson", nil, nil, false},
+		{"tree.ged", "tree.json", nil, nil, false},
+		{"tree.ged", "tree.ndjson", &gedcomSpec.ValidateOptions{AncestryCycles: gedcomSpec.AncestryCyclesBreak}, nil, false},
+		{"tree.ged", "tree.ndjson", &gedcomSpec.ValidateOptions{Lint: &gedcomSpec.LintOptions{}}, nil, false},
+		{"tree.ged", "tree.ndjson", nil, &gedcomSpec.ExportOptions{ApplyRestrictions: true}, false},
+		{"tree.ged", "tree.ndjson", nil, &gedcomSpec.ExportOptions{Living: gedcomSpec.LivingRedact}, false},
+	} {
+		if streams := streamsNDJSON(c.inputFilePath, c.outputFilePath, c.validateOptions, c.exportOptions); streams != c.expected {
+			t.Errorf("expected parsing %s to %s with %v and %v to stream: %t, got %t", c.inputFilePath, c.outputFilePath, c.validateOptions, c.exportOptions, c.expected, streams)
+		}
+	}
+}
+
+func TestWriteTablesDelimitsTabSeparatedFilesByTabs(t *testing.T) {
+	outputDirectory, err := ioutil.TempDir("", "tables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outputDirectory)
+
+	gedcom := ReadGedcom(strings.NewReader(strings.Join([]string{
+		"0 HEAD",
+		"0 @I1@ INDI",
+		"1 NAME Harry /Potter/",
+		"0 TRLR",
+	}, "\n")))
+	for _, c := range []struct {
+		extension string
+		delimiter string
+		expected  string
+	}{
+		{".tsv", "", "\t"},
+		{".csv", "", ","},
+		{".tsv", ";", ";"},
+	} {
+		delimiter, err := gedcomSpec.ParseCSVDelimiter(c.delimiter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outputFilePath := filepath.Join(outputDirectory, "tree"+c.extension)
+		if err := writeTables(gedcom, outputFilePath, &gedcomSpec.ExportOptions{CSVDelimiter: delimiter}); err != nil {
+			t.Fatalf("failed to write tables with error: %s", err)
+		}
+		names, err := ioutil.ReadFile(TableFilePath(outputFilePath, gedcomSpec.NamesTable))
+		if err != nil {
+			t.Fatal(err)
+		}
+		header := strings.SplitN(string(names), "\n", 2)[0]
+		if !strings.Contains(header, "individual_id"+c.expected) {
+			t.Errorf("expected %s file with delimiter %q to be delimited by %q, found header %q", c.extension, c.delimiter, c.expected, header)
+		}
+	}
+}
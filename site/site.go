@@ -0,0 +1,459 @@
+package site
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+	"google.golang.org/protobuf/proto"
+)
+
+/*
+A site is a static HTML website of a gedcom: index.html lists the individuals by surname, individuals/ and families/
+hold a page per individual and family, named after their xRefIds, and search.json is an index of every individual
+for searching the site in the browser. Every link is relative, so sites can be published from any directory.
+*/
+
+const (
+	IndexFile             = "index.html"
+	SearchIndexFile       = "search.json"
+	StylesheetFile        = "style.css"
+	IndividualsDirectory  = "individuals"
+	FamiliesDirectory     = "families"
+	unknownSurname        = "Unknown"
+	unknownIndividualName = "Unknown"
+)
+
+// SearchEntry is an individual in the search index of a site
+type SearchEntry struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Birth string `json:"birth,omitempty"`
+	Death string `json:"death,omitempty"`
+	URL   string `json:"url"`
+}
+
+// site holds the records of the gedcom a site is generated for, by their xRefIds
+type site struct {
+	individuals      map[string]*gedcomSpec.Gedcom_Individual
+	families         map[string]*gedcomSpec.Gedcom_Family
+	notes            map[string]*gedcomSpec.Gedcom_Note
+	sources          map[string]*gedcomSpec.Gedcom_Source
+	childFamilyIds   map[string][]string
+	spouseFamilyIds  map[string][]string
+	pedigreesByLinks map[[2]string]string
+}
+
+type individualLink struct {
+	Name  string
+	Years string
+	URL   string
+}
+
+type familyLink struct {
+	Name string
+	URL  string
+}
+
+type event struct {
+	Type  string
+	Date  string
+	Place string
+}
+
+// citation names its source by title and author, or by xRefId if the source doesn't exist or has no title
+type citation struct {
+	Title       string
+	Author      string
+	Page        string
+	Description string
+	Quality     string
+}
+
+type surnameGroup struct {
+	Surname     string
+	Anchor      string
+	Individuals []*individualLink
+}
+
+type indexPage struct {
+	Root     string
+	Title    string
+	Surnames []*surnameGroup
+}
+
+type parentsFamily struct {
+	Family   *familyLink
+	Father   *individualLink
+	Mother   *individualLink
+	Pedigree string
+}
+
+type spouseFamily struct {
+	Family   *familyLink
+	Spouse   *individualLink
+	Events   []*event
+	Children []*individualLink
+}
+
+type individualPage struct {
+	Root      string
+	Title     string
+	Id        string
+	Gender    string
+	Names     []string
+	Events    []*event
+	Parents   []*parentsFamily
+	Families  []*spouseFamily
+	Citations []*citation
+	Notes     []string
+}
+
+type familyPage struct {
+	Root      string
+	Title     string
+	Id        string
+	Father    *individualLink
+	Mother    *individualLink
+	Events    []*event
+	Children  []*individualLink
+	Citations []*citation
+	Notes     []string
+}
+
+// Write generates a static HTML website of a gedcom in a directory, which is created if it doesn't exist yet.
+// The export options determine which data the site holds, e.g. to leave out individuals who might still be alive.
+func Write(gedcom *gedcomSpec.ConcurrencySafeGedcom, directory string, options *gedcomSpec.ExportOptions) error {
+	s := newSite(gedcom.Exported(options))
+	for _, subdirectory := range []string{IndividualsDirectory, FamiliesDirectory} {
+		err := os.MkdirAll(filepath.Join(directory, subdirectory), 0755)
+		if err != nil {
+			return fmt.Errorf("failed to create site directory with error: %s", err)
+		}
+	}
+
+	files := map[string][]byte{StylesheetFile: []byte(stylesheet)}
+	index, err := render(indexTemplate, s.indexPage())
+	if err != nil {
+		return err
+	}
+	files[IndexFile] = index
+	searchIndex, err := json.Marshal(s.searchIndex())
+	if err != nil {
+		return err
+	}
+	files[SearchIndexFile] = searchIndex
+	for id := range s.individuals {
+		page, err := render(individualTemplate, s.individualPage(id))
+		if err != nil {
+			return err
+		}
+		files[IndividualPath(id)] = page
+	}
+	for id := range s.families {
+		page, err := render(familyTemplate, s.familyPage(id))
+		if err != nil {
+			return err
+		}
+		files[FamilyPath(id)] = page
+	}
+
+	for path, content := range files {
+		err := ioutil.WriteFile(filepath.Join(directory, filepath.FromSlash(path)), content, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to write site file %s with error: %s", path, err)
+		}
+	}
+	return nil
+}
+
+var unsafeFileNameCharacters = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// pageName names the page of a record after its xRefId, without its @s and characters unsafe in file names
+func pageName(id string) string {
+	return unsafeFileNameCharacters.ReplaceAllString(strings.Trim(id, "@"), "_") + ".html"
+}
+
+// IndividualPath is the path of the page of an individual, relative to the root of a site
+func IndividualPath(id string) string {
+	return IndividualsDirectory + "/" + pageName(id)
+}
+
+// FamilyPath is the path of the page of a family, relative to the root of a site
+func FamilyPath(id string) string {
+	return FamiliesDirectory + "/" + pageName(id)
+}
+
+func newSite(gedcom *gedcomSpec.Gedcom) *site {
+	s := &site{
+		individuals:      map[string]*gedcomSpec.Gedcom_Individual{},
+		families:         map[string]*gedcomSpec.Gedcom_Family{},
+		notes:            map[string]*gedcomSpec.Gedcom_Note{},
+		sources:          map[string]*gedcomSpec.Gedcom_Source{},
+		childFamilyIds:   map[string][]string{},
+		spouseFamilyIds:  map[string][]string{},
+		pedigreesByLinks: map[[2]string]string{},
+	}
+	for _, i := range gedcom.Individuals {
+		s.individuals[i.Id] = i
+		for _, link := range i.ChildToFamilyLinks {
+			s.pedigreesByLinks[[2]string{link.FamilyId, i.Id}] = link.Pedigree
+		}
+	}
+	for _, n := range gedcom.Notes {
+		s.notes[n.Id] = n
+	}
+	for _, source := range gedcom.Sources {
+		s.sources[source.Id] = source
+	}
+	families := append([]*gedcomSpec.Gedcom_Family{}, gedcom.Families...)
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].Id < families[j].Id
+	})
+	for _, f := range families {
+		s.families[f.Id] = f
+		for _, spouseId := range []string{f.FatherId, f.MotherId} {
+			if spouseId != "" {
+				s.spouseFamilyIds[spouseId] = append(s.spouseFamilyIds[spouseId], f.Id)
+			}
+		}
+		for _, childId := range f.ChildIds {
+			s.childFamilyIds[childId] = append(s.childFamilyIds[childId], f.Id)
+		}
+	}
+	return s
+}
+
+// individualName is the first name of an individual, as their given name followed by their surname
+func individualName(i *gedcomSpec.Gedcom_Individual) string {
+	if len(i.Names) > 0 {
+		if name := strings.TrimSpace(i.Names[0].GivenName + " " + i.Names[0].Surname); name != "" {
+			return name
+		}
+	}
+	return unknownIndividualName
+}
+
+// lifeYears formats the dates of the first birth and death events of an individual, e.g. "1900 - 1980"
+func lifeYears(i *gedcomSpec.Gedcom_Individual) string {
+	birth, death := firstEventDate(i.BirthEvents), firstEventDate(i.DeathEvents)
+	if birth == "" && death == "" {
+		return ""
+	}
+	return strings.TrimSpace(birth + " - " + death)
+}
+
+func firstEventDate(events []*gedcomSpec.Gedcom_Individual_Event) string {
+	for _, e := range events {
+		if e.Date != nil && e.Date.Year != "" {
+			return e.Date.Year
+		}
+	}
+	return ""
+}
+
+// individualLink links to the page of an individual, or is nil if the individual doesn't exist
+func (s *site) individualLink(id string) *individualLink {
+	i, ok := s.individuals[id]
+	if !ok {
+		return nil
+	}
+	return &individualLink{Name: individualName(i), Years: lifeYears(i), URL: IndividualPath(id)}
+}
+
+// familyLink links to the page of a family, named after its spouses
+func (s *site) familyLink(f *gedcomSpec.Gedcom_Family) *familyLink {
+	spouseNames := []string{}
+	for _, spouseId := range []string{f.FatherId, f.MotherId} {
+		if spouse, ok := s.individuals[spouseId]; ok {
+			spouseNames = append(spouseNames, individualName(spouse))
+		}
+	}
+	name := strings.Join(spouseNames, " & ")
+	if name == "" {
+		name = f.Id
+	}
+	return &familyLink{Name: name, URL: FamilyPath(f.Id)}
+}
+
+func (s *site) childLinks(f *gedcomSpec.Gedcom_Family) []*individualLink {
+	children := []*individualLink{}
+	for _, childId := range f.ChildIds {
+		if child := s.individualLink(childId); child != nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func (s *site) indexPage() *indexPage {
+	individualsBySurnames := map[string][]*individualLink{}
+	for id, i := range s.individuals {
+		surname := ""
+		if len(i.Names) > 0 {
+			surname = strings.TrimSpace(i.Names[0].Surname)
+		}
+		individualsBySurnames[surname] = append(individualsBySurnames[surname], s.individualLink(id))
+	}
+	surnames := make([]string, 0, len(individualsBySurnames))
+	for surname := range individualsBySurnames {
+		surnames = append(surnames, surname)
+	}
+	// individuals without a surname are listed last
+	sort.Slice(surnames, func(i, j int) bool {
+		if surnames[i] == "" || surnames[j] == "" {
+			return surnames[j] == ""
+		}
+		return strings.ToLower(surnames[i]) < strings.ToLower(surnames[j])
+	})
+
+	page := &indexPage{Title: "Family tree"}
+	for n, surname := range surnames {
+		individuals := individualsBySurnames[surname]
+		sort.Slice(individuals, func(i, j int) bool {
+			if individuals[i].Name != individuals[j].Name {
+				return individuals[i].Name < individuals[j].Name
+			}
+			return individuals[i].URL < individuals[j].URL
+		})
+		if surname == "" {
+			surname = unknownSurname
+		}
+		page.Surnames = append(page.Surnames, &surnameGroup{Surname: surname, Anchor: fmt.Sprintf("surname-%d", n+1), Individuals: individuals})
+	}
+	return page
+}
+
+func (s *site) searchIndex() []*SearchEntry {
+	entries := []*SearchEntry{}
+	for id, i := range s.individuals {
+		entry := &SearchEntry{Id: id, Name: individualName(i), URL: IndividualPath(id)}
+		if len(i.BirthEvents) > 0 {
+			entry.Birth = gedcomSpec.DateValue(i.BirthEvents[0].Date)
+		}
+		if len(i.DeathEvents) > 0 {
+			entry.Death = gedcomSpec.DateValue(i.DeathEvents[0].Date)
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Name != entries[j].Name {
+			return entries[i].Name < entries[j].Name
+		}
+		return entries[i].Id < entries[j].Id
+	})
+	return entries
+}
+
+func (s *site) individualPage(id string) *individualPage {
+	i := s.individuals[id]
+	page := &individualPage{Root: "../", Title: individualName(i), Id: id, Gender: strings.Title(strings.ToLower(i.Gender))}
+	for _, name := range i.Names {
+		page.Names = append(page.Names, strings.TrimSpace(name.GivenName+" "+name.Surname))
+	}
+	for _, eventType := range gedcomSpec.IndividualEventTypes {
+		page.Events = append(page.Events, events(eventType.Name, *eventType.Events(i))...)
+	}
+	for _, familyId := range s.childFamilyIds[id] {
+		f := s.families[familyId]
+		page.Parents = append(page.Parents, &parentsFamily{
+			Family:   s.familyLink(f),
+			Father:   s.individualLink(f.FatherId),
+			Mother:   s.individualLink(f.MotherId),
+			Pedigree: s.pedigreesByLinks[[2]string{familyId, id}],
+		})
+	}
+	for _, familyId := range s.spouseFamilyIds[id] {
+		f := s.families[familyId]
+		spouseId := f.FatherId
+		if spouseId == id {
+			spouseId = f.MotherId
+		}
+		page.Families = append(page.Families, &spouseFamily{
+			Family:   s.familyLink(f),
+			Spouse:   s.individualLink(spouseId),
+			Events:   events("Marriage", f.MarriageEvents),
+			Children: s.childLinks(f),
+		})
+	}
+	page.Citations, page.Notes = s.references(i)
+	return page
+}
+
+func (s *site) familyPage(id string) *familyPage {
+	f := s.families[id]
+	page := &familyPage{
+		Root:     "../",
+		Title:    s.familyLink(f).Name,
+		Id:       id,
+		Father:   s.individualLink(f.FatherId),
+		Mother:   s.individualLink(f.MotherId),
+		Events:   events("Marriage", f.MarriageEvents),
+		Children: s.childLinks(f),
+	}
+	page.Citations, page.Notes = s.references(f)
+	return page
+}
+
+func events(eventType string, events []*gedcomSpec.Gedcom_Individual_Event) []*event {
+	result := []*event{}
+	for _, e := range events {
+		result = append(result, &event{Type: eventType, Date: gedcomSpec.DateValue(e.Date), Place: e.Place})
+	}
+	return result
+}
+
+// references collects the source citations and notes anywhere in a record, e.g. in its events, associations, non-events
+// and LDS ordinances. Notes are the text of the note records they point to or their own text.
+func (s *site) references(record proto.Message) ([]*citation, []string) {
+	citations, notes := []*citation{}, []string{}
+	for _, c := range gedcomSpec.SourceCitations(record) {
+		citations = append(citations, s.citation(c))
+	}
+	for _, n := range gedcomSpec.NoteLinks(record) {
+		notes = append(notes, s.noteText(n))
+	}
+
+	nonEmptyNotes := []string{}
+	for _, note := range notes {
+		if note != "" {
+			nonEmptyNotes = append(nonEmptyNotes, note)
+		}
+	}
+	return citations, nonEmptyNotes
+}
+
+func (s *site) citation(c *gedcomSpec.Gedcom_SourceCitation) *citation {
+	result := &citation{Title: c.SourceId, Page: c.Page, Description: c.Description, Quality: c.Quality}
+	if source, ok := s.sources[c.SourceId]; ok {
+		if source.Title != "" {
+			result.Title = source.Title
+		}
+		result.Author = source.Author
+	}
+	return result
+}
+
+func (s *site) noteText(link *gedcomSpec.Gedcom_NoteLink) string {
+	if note, ok := s.notes[link.NoteId]; ok {
+		return note.SubmitterText
+	}
+	return link.SubmitterText
+}
+
+func render(t *template.Template, page interface{}) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{})
+	err := t.Execute(buf, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render site page with error: %s", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,144 @@
+package site
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+)
+
+func testGedcom() *gedcomSpec.ConcurrencySafeGedcom {
+	g := gedcomSpec.NewConcurrencySafeGedcom()
+	g.Individuals = []*gedcomSpec.Gedcom_Individual{
+		{
+			Id:          "@I1@",
+			Gender:      "MALE",
+			Names:       []*gedcomSpec.Gedcom_Individual_Name{{GivenName: "John", Surname: "Smith"}, {GivenName: "Johnny"}},
+			BirthEvents: []*gedcomSpec.Gedcom_Individual_Event{{Date: &gedcomSpec.Gedcom_Individual_Date{Year: "1900", Month: "01", Day: "1"}, Place: "London"}},
+			DeathEvents: []*gedcomSpec.Gedcom_Individual_Event{{Date: &gedcomSpec.Gedcom_Individual_Date{Year: "1980"}}},
+			Associations: []*gedcomSpec.Gedcom_Individual_Association{{
+				IndividualId:    "@I2@",
+				SourceCitations: []*gedcomSpec.Gedcom_SourceCitation{{SourceId: "@S1@", Page: "12"}},
+				Notes:           []*gedcomSpec.Gedcom_NoteLink{{NoteId: "@N1@"}},
+			}},
+		},
+		{
+			Id:    "@I2@",
+			Names: []*gedcomSpec.Gedcom_Individual_Name{{GivenName: "Mary", Surname: "<Jones>"}},
+		},
+		{
+			Id:                 "@I3@",
+			Names:              []*gedcomSpec.Gedcom_Individual_Name{{GivenName: "Jim", Surname: "Smith"}},
+			ChildToFamilyLinks: []*gedcomSpec.Gedcom_Individual_FamilyLink{{FamilyId: "@F1@", Pedigree: "adopted"}},
+		},
+		{
+			Id:    "@I4@",
+			Names: []*gedcomSpec.Gedcom_Individual_Name{{GivenName: "Anonymous"}},
+		},
+	}
+	g.Families = []*gedcomSpec.Gedcom_Family{
+		{
+			Id:              "@F1@",
+			FatherId:        "@I1@",
+			MotherId:        "@I2@",
+			ChildIds:        []string{"@I3@"},
+			MarriageEvents:  []*gedcomSpec.Gedcom_Individual_Event{{Date: &gedcomSpec.Gedcom_Individual_Date{Year: "1925"}}},
+			SourceCitations: []*gedcomSpec.Gedcom_SourceCitation{{SourceId: "@S2@", Quality: "3"}},
+		},
+	}
+	g.Sources = []*gedcomSpec.Gedcom_Source{{Id: "@S1@", Title: "Parish register of <St Mary>", Author: "Church of England"}}
+	g.Notes = []*gedcomSpec.Gedcom_Note{{Id: "@N1@", SubmitterText: "Known as the smith of London"}}
+	return g
+}
+
+func TestWrite(t *testing.T) {
+	directory, err := ioutil.TempDir("", "site")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	if err := Write(testGedcom(), directory, nil); err != nil {
+		t.Fatalf("failed to write site with error: %s", err)
+	}
+	readFile := func(path string) string {
+		content, err := ioutil.ReadFile(filepath.Join(directory, filepath.FromSlash(path)))
+		if err != nil {
+			t.Fatalf("failed to read site file %s with error: %s", path, err)
+		}
+		return string(content)
+	}
+
+	pages := []struct {
+		path     string
+		expected []string
+	}{
+		{IndexFile, []string{
+			`<h2 id="surname-1">&lt;Jones&gt;</h2>`,
+			`<h2 id="surname-2">Smith</h2>`,
+			`<h2 id="surname-3">Unknown</h2>`,
+			`<li><a href="individuals/I1.html">John Smith</a> <span class="years">(1900 - 1980)</span></li>`,
+			`<li><a href="individuals/I4.html">Anonymous</a></li>`,
+		}},
+		{IndividualPath("@I1@"), []string{
+			`<base href="../">`,
+			`<li>Johnny</li>`,
+			`<tr><th>Birth</th><td>1 JAN 1900</td><td>London</td></tr>`,
+			`<h2>Family with Mary &lt;Jones&gt;</h2>`,
+			`<tr><th>Marriage</th><td>1925</td><td></td></tr>`,
+			`<li><a href="individuals/I3.html">Jim Smith</a></li>`,
+			`<li><cite>Parish register of &lt;St Mary&gt;</cite> by Church of England, 12</li>`,
+			`<p class="note">Known as the smith of London</p>`,
+		}},
+		{IndividualPath("@I3@"), []string{
+			`<a href="individuals/I1.html">John Smith</a> <span class="years">(1900 - 1980)</span> &amp; <a href="individuals/I2.html">Mary &lt;Jones&gt;</a> <span class="pedigree">(adopted)</span> <a class="family" href="families/F1.html">family</a>`,
+		}},
+		{FamilyPath("@F1@"), []string{
+			`<h1>John Smith &amp; Mary &lt;Jones&gt;</h1>`,
+			`<li><cite>@S2@</cite> <span class="quality">(quality 3)</span></li>`,
+			`<li><a href="individuals/I3.html">Jim Smith</a></li>`,
+		}},
+	}
+	for _, page := range pages {
+		content := readFile(page.path)
+		for _, expected := range page.expected {
+			if !strings.Contains(content, expected) {
+				t.Errorf("expected %s to contain %q, found %s", page.path, expected, content)
+			}
+		}
+	}
+
+	searchIndex := []*SearchEntry{}
+	if err := json.Unmarshal([]byte(readFile(SearchIndexFile)), &searchIndex); err != nil {
+		t.Fatalf("failed to read search index with error: %s", err)
+	}
+	expected := []*SearchEntry{
+		{Id: "@I4@", Name: "Anonymous", URL: "individuals/I4.html"},
+		{Id: "@I3@", Name: "Jim Smith", URL: "individuals/I3.html"},
+		{Id: "@I1@", Name: "John Smith", Birth: "1 JAN 1900", Death: "1980", URL: "individuals/I1.html"},
+		{Id: "@I2@", Name: "Mary <Jones>", URL: "individuals/I2.html"},
+	}
+	if !reflect.DeepEqual(searchIndex, expected) {
+		t.Errorf("expected search index %v, found %v", expected, searchIndex)
+	}
+}
+
+func TestPagePaths(t *testing.T) {
+	cases := []struct {
+		id       string
+		expected string
+	}{
+		{"@I1@", "individuals/I1.html"},
+		{"@I/../1@", "individuals/I____1.html"},
+	}
+	for _, c := range cases {
+		if actual := IndividualPath(c.id); actual != c.expected {
+			t.Errorf("IndividualPath(%q) = %q, expected %q", c.id, actual, c.expected)
+		}
+	}
+}
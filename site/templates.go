@@ -0,0 +1,131 @@
+package site
+
+import "html/template"
+
+const layout = `{{define "layout"}}<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+{{with .Root}}<base href="{{.}}">
+{{end}}<title>{{.Title}}</title>
+<link rel="stylesheet" href="style.css">
+</head>
+<body>
+<nav><a href="index.html">Index</a></nav>
+<main>
+<h1>{{.Title}}</h1>
+{{template "content" .}}
+</main>
+</body>
+</html>
+{{end}}
+{{define "individual"}}<a href="{{.URL}}">{{.Name}}</a>{{with .Years}} <span class="years">({{.}})</span>{{end}}{{end}}
+{{define "events"}}{{if .}}<table class="events">
+{{range .}}<tr><th>{{.Type}}</th><td>{{.Date}}</td><td>{{.Place}}</td></tr>
+{{end}}</table>
+{{end}}{{end}}
+{{define "references"}}{{if .Citations}}<h2>Sources</h2>
+<ul>
+{{range .Citations}}<li><cite>{{.Title}}</cite>{{with .Author}} by {{.}}{{end}}{{with .Page}}, {{.}}{{end}}{{with .Description}}: {{.}}{{end}}{{with .Quality}} <span class="quality">(quality {{.}})</span>{{end}}</li>
+{{end}}</ul>
+{{end}}{{if .Notes}}<h2>Notes</h2>
+{{range .Notes}}<p class="note">{{.}}</p>
+{{end}}{{end}}{{end}}`
+
+const index = `{{define "content"}}<form class="search" onsubmit="return false">
+<input id="search" type="search" placeholder="Search individuals" autocomplete="off">
+<ul id="results"></ul>
+</form>
+<p class="surnames">{{range .Surnames}}<a href="#{{.Anchor}}">{{.Surname}}</a> {{end}}</p>
+{{range .Surnames}}<h2 id="{{.Anchor}}">{{.Surname}}</h2>
+<ul>
+{{range .Individuals}}<li>{{template "individual" .}}</li>
+{{end}}</ul>
+{{end}}<script>
+var searchIndex = null;
+document.getElementById("search").addEventListener("input", function (event) {
+	var query = event.target.value.trim().toLowerCase();
+	var show = function () {
+		var results = document.getElementById("results");
+		results.innerHTML = "";
+		if (!query) {
+			return;
+		}
+		searchIndex.filter(function (entry) {
+			return entry.name.toLowerCase().indexOf(query) !== -1;
+		}).slice(0, 50).forEach(function (entry) {
+			var link = document.createElement("a");
+			link.href = entry.url;
+			link.textContent = entry.name + (entry.birth || entry.death ? " (" + (entry.birth || "") + " - " + (entry.death || "") + ")" : "");
+			var item = document.createElement("li");
+			item.appendChild(link);
+			results.appendChild(item);
+		});
+	};
+	if (searchIndex) {
+		show();
+		return;
+	}
+	fetch("search.json").then(function (response) {
+		return response.json();
+	}).then(function (entries) {
+		searchIndex = entries;
+		show();
+	});
+});
+</script>
+{{end}}`
+
+const individual = `{{define "content"}}{{if .Gender}}<p class="gender">{{.Gender}}</p>
+{{end}}{{if gt (len .Names) 1}}<h2>Names</h2>
+<ul>
+{{range .Names}}<li>{{.}}</li>
+{{end}}</ul>
+{{end}}{{if .Events}}<h2>Events</h2>
+{{template "events" .Events}}{{end}}{{if .Parents}}<h2>Parents</h2>
+{{range .Parents}}<p>{{with .Father}}{{template "individual" .}}{{end}}{{if and .Father .Mother}} &amp; {{end}}{{with .Mother}}{{template "individual" .}}{{end}}{{with .Pedigree}} <span class="pedigree">({{.}})</span>{{end}} <a class="family" href="{{.Family.URL}}">family</a></p>
+{{end}}{{end}}{{range .Families}}<h2>Family with {{with .Spouse}}{{.Name}}{{else}}unknown spouse{{end}}</h2>
+<p>{{with .Spouse}}{{template "individual" .}} {{end}}<a class="family" href="{{.Family.URL}}">family</a></p>
+{{template "events" .Events}}{{if .Children}}<h3>Children</h3>
+<ul>
+{{range .Children}}<li>{{template "individual" .}}</li>
+{{end}}</ul>
+{{end}}{{end}}{{template "references" .}}{{end}}`
+
+const family = `{{define "content"}}<h2>Spouses</h2>
+<ul>
+{{with .Father}}<li>{{template "individual" .}}</li>
+{{end}}{{with .Mother}}<li>{{template "individual" .}}</li>
+{{end}}</ul>
+{{if .Events}}<h2>Events</h2>
+{{template "events" .Events}}{{end}}{{if .Children}}<h2>Children</h2>
+<ul>
+{{range .Children}}<li>{{template "individual" .}}</li>
+{{end}}</ul>
+{{end}}{{template "references" .}}{{end}}`
+
+const stylesheet = `body { font-family: sans-serif; margin: 0; color: #222; }
+nav { background: #345; padding: 0.5em 1em; }
+nav a { color: #fff; text-decoration: none; }
+main { max-width: 50em; margin: 0 auto; padding: 1em; }
+a { color: #246; }
+.years, .pedigree, .quality { color: #666; }
+.surnames a { margin-right: 0.5em; }
+.events th { text-align: left; padding-right: 1em; }
+.events td { padding-right: 1em; }
+.note { white-space: pre-wrap; }
+.search input { width: 100%; padding: 0.5em; font-size: 1em; }
+`
+
+var (
+	indexTemplate      = pageTemplate(index)
+	individualTemplate = pageTemplate(individual)
+	familyTemplate     = pageTemplate(family)
+)
+
+// pageTemplate parses the template of a page, which defines the content of the layout.
+// Links are relative to the root of the site, which pages in subdirectories point to as the base of their links.
+func pageTemplate(content string) *template.Template {
+	return template.Must(template.Must(template.New("page").Parse(layout)).Parse(content)).Lookup("layout")
+}
@@ -0,0 +1,612 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+	"os"
+
+	// pure Go SQLite driver, so the parser builds without cgo for every platform
+	_ "modernc.org/sqlite"
+)
+
+// name of the SQLite driver
+const driverName = "sqlite"
+
+/*
+Schema of SQLite databases holding a gedcom. Records are identified by their xRefIds, places by a number.
+Dates are stored as their parts, e.g. date_year, date_month (01-12) and date_day, along with their phrase.
+Citations are the source citations anywhere in individuals and families, e.g. of the records themselves and of their events.
+The header and submission record are stored as their JSON representation in the metadata table.
+*/
+var schema = []string{
+	`CREATE TABLE metadata (
+		key TEXT PRIMARY KEY,
+		value TEXT NOT NULL
+	)`,
+	`CREATE TABLE individuals (
+		id TEXT PRIMARY KEY,
+		gender TEXT NOT NULL,
+		restriction TEXT NOT NULL,
+		automated_record_id TEXT NOT NULL
+	)`,
+	`CREATE TABLE names (
+		individual_id TEXT NOT NULL REFERENCES individuals (id),
+		position INTEGER NOT NULL,
+		given_name TEXT NOT NULL,
+		surname TEXT NOT NULL,
+		is_primary INTEGER NOT NULL
+	)`,
+	`CREATE TABLE places (
+		id INTEGER PRIMARY KEY,
+		name TEXT NOT NULL UNIQUE
+	)`,
+	`CREATE TABLE families (
+		id TEXT PRIMARY KEY,
+		father_id TEXT REFERENCES individuals (id),
+		mother_id TEXT REFERENCES individuals (id),
+		restriction TEXT NOT NULL,
+		automated_record_id TEXT NOT NULL
+	)`,
+	`CREATE TABLE family_children (
+		family_id TEXT NOT NULL REFERENCES families (id),
+		individual_id TEXT NOT NULL REFERENCES individuals (id),
+		position INTEGER NOT NULL,
+		pedigree TEXT NOT NULL
+	)`,
+	`CREATE TABLE events (
+		id INTEGER PRIMARY KEY,
+		individual_id TEXT REFERENCES individuals (id),
+		family_id TEXT REFERENCES families (id),
+		type TEXT NOT NULL,
+		date_year TEXT NOT NULL,
+		date_month TEXT NOT NULL,
+		date_day TEXT NOT NULL,
+		date_phrase TEXT NOT NULL,
+		sort_date_year TEXT NOT NULL,
+		sort_date_month TEXT NOT NULL,
+		sort_date_day TEXT NOT NULL,
+		place_id INTEGER REFERENCES places (id),
+		is_primary INTEGER NOT NULL,
+		restriction TEXT NOT NULL
+	)`,
+	`CREATE TABLE sources (
+		id TEXT PRIMARY KEY,
+		author TEXT NOT NULL,
+		title TEXT NOT NULL,
+		publication TEXT NOT NULL,
+		automated_record_id TEXT NOT NULL
+	)`,
+	`CREATE TABLE citations (
+		id INTEGER PRIMARY KEY,
+		individual_id TEXT REFERENCES individuals (id),
+		family_id TEXT REFERENCES families (id),
+		source_id TEXT REFERENCES sources (id),
+		description TEXT NOT NULL,
+		page TEXT NOT NULL,
+		quality TEXT NOT NULL
+	)`,
+	`CREATE TABLE notes (
+		id TEXT PRIMARY KEY,
+		text TEXT NOT NULL,
+		automated_record_id TEXT NOT NULL
+	)`,
+	`CREATE TABLE repositories (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		automated_record_id TEXT NOT NULL
+	)`,
+	`CREATE TABLE multimedia (
+		id TEXT PRIMARY KEY,
+		automated_record_id TEXT NOT NULL
+	)`,
+	`CREATE TABLE multimedia_files (
+		multimedia_id TEXT NOT NULL REFERENCES multimedia (id),
+		position INTEGER NOT NULL,
+		reference TEXT NOT NULL,
+		format TEXT NOT NULL
+	)`,
+	`CREATE TABLE submitters (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		automated_record_id TEXT NOT NULL
+	)`,
+	`CREATE INDEX names_individual_id ON names (individual_id)`,
+	`CREATE INDEX names_surname ON names (surname)`,
+	`CREATE INDEX families_father_id ON families (father_id)`,
+	`CREATE INDEX families_mother_id ON families (mother_id)`,
+	`CREATE INDEX family_children_family_id ON family_children (family_id)`,
+	`CREATE INDEX family_children_individual_id ON family_children (individual_id)`,
+	`CREATE INDEX events_individual_id ON events (individual_id)`,
+	`CREATE INDEX events_family_id ON events (family_id)`,
+	`CREATE INDEX events_type_date_year ON events (type, date_year)`,
+	`CREATE INDEX events_place_id ON events (place_id)`,
+	`CREATE INDEX citations_individual_id ON citations (individual_id)`,
+	`CREATE INDEX citations_family_id ON citations (family_id)`,
+	`CREATE INDEX citations_source_id ON citations (source_id)`,
+}
+
+// keys of the metadata table
+const (
+	headerKey     = "header"
+	submissionKey = "submission"
+)
+
+// Write writes a gedcom into a new SQLite database at the given path, replacing any existing file
+func Write(gedcom *gedcomSpec.ConcurrencySafeGedcom, databaseFilePath string, options *gedcomSpec.ExportOptions) error {
+	err := os.Remove(databaseFilePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to replace database at %s with error: %s", databaseFilePath, err)
+	}
+	db, err := sql.Open(driverName, databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	w := &writer{tx: tx, placeIdsByNames: map[string]int64{}}
+	err = w.write(gedcom.Exported(options))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+type writer struct {
+	tx              *sql.Tx
+	placeIdsByNames map[string]int64
+}
+
+func (w *writer) write(gedcom *gedcomSpec.Gedcom) error {
+	for _, statement := range schema {
+		_, err := w.tx.Exec(statement)
+		if err != nil {
+			return fmt.Errorf("failed to create schema with error: %s", err)
+		}
+	}
+
+	if gedcom.Header != nil {
+		err := w.writeMetadata(headerKey, gedcom.Header)
+		if err != nil {
+			return err
+		}
+	}
+	if gedcom.Submission != nil {
+		err := w.writeMetadata(submissionKey, gedcom.Submission)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, i := range gedcom.Individuals {
+		err := w.exec(`INSERT INTO individuals VALUES (?, ?, ?, ?)`, i.Id, i.Gender, i.Restriction, i.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		for position, n := range i.Names {
+			err = w.exec(`INSERT INTO names VALUES (?, ?, ?, ?, ?)`, i.Id, position, n.GivenName, n.Surname, n.Primary)
+			if err != nil {
+				return err
+			}
+		}
+		for _, eventType := range gedcomSpec.IndividualEventTypes {
+			for _, event := range *eventType.Events(i) {
+				err = w.writeEvent(i.Id, "", eventType.Tag, event)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		err = w.writeCitations(i.Id, "", gedcomSpec.SourceCitations(i))
+		if err != nil {
+			return err
+		}
+	}
+
+	pedigreesByFamilyAndChildIds := map[[2]string]string{}
+	for _, i := range gedcom.Individuals {
+		for _, link := range i.ChildToFamilyLinks {
+			pedigreesByFamilyAndChildIds[[2]string{link.FamilyId, i.Id}] = link.Pedigree
+		}
+	}
+	for _, f := range gedcom.Families {
+		err := w.exec(`INSERT INTO families VALUES (?, ?, ?, ?, ?)`, f.Id, nullable(f.FatherId), nullable(f.MotherId), f.Restriction, f.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		for position, childId := range f.ChildIds {
+			err = w.exec(`INSERT INTO family_children VALUES (?, ?, ?, ?)`, f.Id, childId, position, pedigreesByFamilyAndChildIds[[2]string{f.Id, childId}])
+			if err != nil {
+				return err
+			}
+		}
+		for _, event := range f.MarriageEvents {
+			err = w.writeEvent("", f.Id, gedcomSpec.MarriageEventTag, event)
+			if err != nil {
+				return err
+			}
+		}
+		err = w.writeCitations("", f.Id, gedcomSpec.SourceCitations(f))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, s := range gedcom.Sources {
+		err := w.exec(`INSERT INTO sources VALUES (?, ?, ?, ?, ?)`, s.Id, s.Author, s.Title, s.Publication, s.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+	}
+	for _, n := range gedcom.Notes {
+		err := w.exec(`INSERT INTO notes VALUES (?, ?, ?)`, n.Id, n.SubmitterText, n.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+	}
+	for _, r := range gedcom.Repositories {
+		err := w.exec(`INSERT INTO repositories VALUES (?, ?, ?)`, r.Id, r.Name, r.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+	}
+	for _, m := range gedcom.Multimedias {
+		err := w.exec(`INSERT INTO multimedia VALUES (?, ?)`, m.Id, m.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		for position, file := range m.Files {
+			err = w.exec(`INSERT INTO multimedia_files VALUES (?, ?, ?, ?)`, m.Id, position, file.Reference, file.Format)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, s := range gedcom.Submitters {
+		err := w.exec(`INSERT INTO submitters VALUES (?, ?, ?)`, s.Id, s.Name, s.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMetadata stores the JSON representation of a structure in the metadata table
+func (w *writer) writeMetadata(key string, structure interface{}) error {
+	value, err := json.Marshal(structure)
+	if err != nil {
+		return fmt.Errorf("failed to store %s with error: %s", key, err)
+	}
+	return w.exec(`INSERT INTO metadata VALUES (?, ?)`, key, string(value))
+}
+
+// writeCitations writes the source citations of an individual or a family
+func (w *writer) writeCitations(individualId string, familyId string, citations []*gedcomSpec.Gedcom_SourceCitation) error {
+	for _, citation := range citations {
+		err := w.exec(`INSERT INTO citations (individual_id, family_id, source_id, description, page, quality) VALUES (?, ?, ?, ?, ?, ?)`,
+			nullable(individualId), nullable(familyId), nullable(citation.SourceId), citation.Description, citation.Page, citation.Quality)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *writer) exec(query string, args ...interface{}) error {
+	_, err := w.tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute %s with error: %s", query, err)
+	}
+	return nil
+}
+
+func (w *writer) writeEvent(individualId string, familyId string, eventType string, event *gedcomSpec.Gedcom_Individual_Event) error {
+	placeId, err := w.placeId(event.Place)
+	if err != nil {
+		return err
+	}
+	return w.exec(`INSERT INTO events (individual_id, family_id, type, date_year, date_month, date_day, date_phrase, sort_date_year, sort_date_month, sort_date_day, place_id, is_primary, restriction) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		nullable(individualId), nullable(familyId), eventType,
+		event.Date.GetYear(), event.Date.GetMonth(), event.Date.GetDay(), event.Date.GetPhrase(),
+		event.SortDate.GetYear(), event.SortDate.GetMonth(), event.SortDate.GetDay(),
+		placeId, event.Primary, event.Restriction)
+}
+
+// placeId returns the id of a place, adding it to the places the first time it's used
+func (w *writer) placeId(place string) (interface{}, error) {
+	if place == "" {
+		return nil, nil
+	}
+	if id, ok := w.placeIdsByNames[place]; ok {
+		return id, nil
+	}
+	result, err := w.tx.Exec(`INSERT INTO places (name) VALUES (?)`, place)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add place %s with error: %s", place, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	w.placeIdsByNames[place] = id
+	return id, nil
+}
+
+// nullable stores empty pointers as NULL, so they don't refer to records that don't exist
+func nullable(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// Read reads a gedcom from a SQLite database with the schema Write creates, without validating it.
+// Citations are read as citations of the individuals and families themselves,
+// as the structures holding them, e.g. events and associations, aren't part of the schema.
+func Read(databaseFilePath string) (*gedcomSpec.ConcurrencySafeGedcom, error) {
+	if _, err := os.Stat(databaseFilePath); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open(driverName, databaseFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	r := &reader{
+		db:               db,
+		gedcom:           gedcomSpec.NewConcurrencySafeGedcom(),
+		individualsByIds: map[string]*gedcomSpec.Gedcom_Individual{},
+		familiesByIds:    map[string]*gedcomSpec.Gedcom_Family{},
+	}
+	r.gedcom.Header = &gedcomSpec.Gedcom_HeaderType{}
+	for _, read := range []func() error{r.readMetadata, r.readIndividuals, r.readNames, r.readFamilies, r.readFamilyChildren, r.readEvents,
+		r.readSources, r.readCitations, r.readNotes, r.readRepositories, r.readMultimedia, r.readSubmitters} {
+		err = read()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r.gedcom, nil
+}
+
+type reader struct {
+	db               *sql.DB
+	gedcom           *gedcomSpec.ConcurrencySafeGedcom
+	individualsByIds map[string]*gedcomSpec.Gedcom_Individual
+	familiesByIds    map[string]*gedcomSpec.Gedcom_Family
+}
+
+// query calls scan for every row of the result of a query
+func (r *reader) query(query string, scan func(rows *sql.Rows) error) error {
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return fmt.Errorf("failed to execute %s with error: %s", query, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		err = scan(rows)
+		if err != nil {
+			return fmt.Errorf("failed to read result of %s with error: %s", query, err)
+		}
+	}
+	return rows.Err()
+}
+
+func (r *reader) readMetadata() error {
+	return r.query(`SELECT key, value FROM metadata`, func(rows *sql.Rows) error {
+		var key, value string
+		err := rows.Scan(&key, &value)
+		if err != nil {
+			return err
+		}
+		switch key {
+		case headerKey:
+			return json.Unmarshal([]byte(value), r.gedcom.Header)
+		case submissionKey:
+			r.gedcom.Submission = &gedcomSpec.Gedcom_SubmissionType{}
+			return json.Unmarshal([]byte(value), r.gedcom.Submission)
+		}
+		return nil
+	})
+}
+
+func (r *reader) readIndividuals() error {
+	return r.query(`SELECT id, gender, restriction, automated_record_id FROM individuals ORDER BY rowid`, func(rows *sql.Rows) error {
+		i := &gedcomSpec.Gedcom_Individual{}
+		err := rows.Scan(&i.Id, &i.Gender, &i.Restriction, &i.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		r.individualsByIds[i.Id] = i
+		r.gedcom.Individuals = append(r.gedcom.Individuals, i)
+		return nil
+	})
+}
+
+func (r *reader) readNames() error {
+	return r.query(`SELECT individual_id, given_name, surname, is_primary FROM names ORDER BY individual_id, position`, func(rows *sql.Rows) error {
+		var individualId string
+		n := &gedcomSpec.Gedcom_Individual_Name{}
+		err := rows.Scan(&individualId, &n.GivenName, &n.Surname, &n.Primary)
+		if err != nil {
+			return err
+		}
+		if i, ok := r.individualsByIds[individualId]; ok {
+			i.Names = append(i.Names, n)
+		}
+		return nil
+	})
+}
+
+func (r *reader) readFamilies() error {
+	return r.query(`SELECT id, father_id, mother_id, restriction, automated_record_id FROM families ORDER BY rowid`, func(rows *sql.Rows) error {
+		f := &gedcomSpec.Gedcom_Family{}
+		var fatherId, motherId sql.NullString
+		err := rows.Scan(&f.Id, &fatherId, &motherId, &f.Restriction, &f.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		f.FatherId, f.MotherId = fatherId.String, motherId.String
+		for _, spouseId := range []string{f.FatherId, f.MotherId} {
+			if spouse, ok := r.individualsByIds[spouseId]; ok {
+				spouse.SpouseToFamilyLinks = append(spouse.SpouseToFamilyLinks, &gedcomSpec.Gedcom_Individual_FamilyLink{FamilyId: f.Id})
+			}
+		}
+		r.familiesByIds[f.Id] = f
+		r.gedcom.Families = append(r.gedcom.Families, f)
+		return nil
+	})
+}
+
+func (r *reader) readFamilyChildren() error {
+	return r.query(`SELECT family_children.family_id, family_children.individual_id, family_children.pedigree FROM family_children JOIN families ON families.id = family_children.family_id ORDER BY families.rowid, family_children.position`, func(rows *sql.Rows) error {
+		var familyId, childId, pedigree string
+		err := rows.Scan(&familyId, &childId, &pedigree)
+		if err != nil {
+			return err
+		}
+		r.familiesByIds[familyId].ChildIds = append(r.familiesByIds[familyId].ChildIds, childId)
+		if child, ok := r.individualsByIds[childId]; ok {
+			child.ChildToFamilyLinks = append(child.ChildToFamilyLinks, &gedcomSpec.Gedcom_Individual_FamilyLink{FamilyId: familyId, Pedigree: pedigree})
+		}
+		return nil
+	})
+}
+
+func (r *reader) readEvents() error {
+	return r.query(`SELECT events.individual_id, events.family_id, events.type, events.date_year, events.date_month, events.date_day, events.date_phrase, events.sort_date_year, events.sort_date_month, events.sort_date_day, places.name, events.is_primary, events.restriction FROM events LEFT JOIN places ON places.id = events.place_id ORDER BY events.id`, func(rows *sql.Rows) error {
+		var individualId, familyId, place sql.NullString
+		var eventType string
+		date, sortDate := &gedcomSpec.Gedcom_Individual_Date{}, &gedcomSpec.Gedcom_Individual_Date{}
+		event := &gedcomSpec.Gedcom_Individual_Event{}
+		err := rows.Scan(&individualId, &familyId, &eventType, &date.Year, &date.Month, &date.Day, &date.Phrase, &sortDate.Year, &sortDate.Month, &sortDate.Day, &place, &event.Primary, &event.Restriction)
+		if err != nil {
+			return err
+		}
+		event.Place = place.String
+		if date.Year != "" || date.Month != "" || date.Day != "" || date.Phrase != "" {
+			event.Date = date
+		}
+		if sortDate.Year != "" || sortDate.Month != "" || sortDate.Day != "" {
+			event.SortDate = sortDate
+		}
+
+		if f, ok := r.familiesByIds[familyId.String]; ok && eventType == gedcomSpec.MarriageEventTag {
+			f.MarriageEvents = append(f.MarriageEvents, event)
+			return nil
+		}
+		i, ok := r.individualsByIds[individualId.String]
+		if !ok {
+			return nil
+		}
+		for _, individualEventType := range gedcomSpec.IndividualEventTypes {
+			if individualEventType.Tag == eventType {
+				events := individualEventType.Events(i)
+				*events = append(*events, event)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *reader) readSources() error {
+	return r.query(`SELECT id, author, title, publication, automated_record_id FROM sources ORDER BY rowid`, func(rows *sql.Rows) error {
+		s := &gedcomSpec.Gedcom_Source{}
+		err := rows.Scan(&s.Id, &s.Author, &s.Title, &s.Publication, &s.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		r.gedcom.Sources = append(r.gedcom.Sources, s)
+		return nil
+	})
+}
+
+func (r *reader) readNotes() error {
+	return r.query(`SELECT id, text, automated_record_id FROM notes ORDER BY rowid`, func(rows *sql.Rows) error {
+		n := &gedcomSpec.Gedcom_Note{}
+		err := rows.Scan(&n.Id, &n.SubmitterText, &n.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		r.gedcom.Notes = append(r.gedcom.Notes, n)
+		return nil
+	})
+}
+
+func (r *reader) readCitations() error {
+	return r.query(`SELECT individual_id, family_id, source_id, description, page, quality FROM citations ORDER BY id`, func(rows *sql.Rows) error {
+		var individualId, familyId, sourceId sql.NullString
+		c := &gedcomSpec.Gedcom_SourceCitation{}
+		err := rows.Scan(&individualId, &familyId, &sourceId, &c.Description, &c.Page, &c.Quality)
+		if err != nil {
+			return err
+		}
+		c.SourceId = sourceId.String
+		if i, ok := r.individualsByIds[individualId.String]; ok {
+			i.SourceCitations = append(i.SourceCitations, c)
+		} else if f, ok := r.familiesByIds[familyId.String]; ok {
+			f.SourceCitations = append(f.SourceCitations, c)
+		}
+		return nil
+	})
+}
+
+func (r *reader) readRepositories() error {
+	return r.query(`SELECT id, name, automated_record_id FROM repositories ORDER BY rowid`, func(rows *sql.Rows) error {
+		repository := &gedcomSpec.Gedcom_Repository{}
+		err := rows.Scan(&repository.Id, &repository.Name, &repository.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		r.gedcom.Repositories = append(r.gedcom.Repositories, repository)
+		return nil
+	})
+}
+
+func (r *reader) readMultimedia() error {
+	multimediaByIds := map[string]*gedcomSpec.Gedcom_Multimedia{}
+	err := r.query(`SELECT id, automated_record_id FROM multimedia ORDER BY rowid`, func(rows *sql.Rows) error {
+		m := &gedcomSpec.Gedcom_Multimedia{}
+		err := rows.Scan(&m.Id, &m.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		multimediaByIds[m.Id] = m
+		r.gedcom.Multimedias = append(r.gedcom.Multimedias, m)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return r.query(`SELECT multimedia_id, reference, format FROM multimedia_files ORDER BY multimedia_id, position`, func(rows *sql.Rows) error {
+		var multimediaId string
+		file := &gedcomSpec.Gedcom_Multimedia_File{}
+		err := rows.Scan(&multimediaId, &file.Reference, &file.Format)
+		if err != nil {
+			return err
+		}
+		if m, ok := multimediaByIds[multimediaId]; ok {
+			m.Files = append(m.Files, file)
+		}
+		return nil
+	})
+}
+
+func (r *reader) readSubmitters() error {
+	return r.query(`SELECT id, name, automated_record_id FROM submitters ORDER BY rowid`, func(rows *sql.Rows) error {
+		s := &gedcomSpec.Gedcom_Submitter{}
+		err := rows.Scan(&s.Id, &s.Name, &s.AutomatedRecordId)
+		if err != nil {
+			return err
+		}
+		r.gedcom.Submitters = append(r.gedcom.Submitters, s)
+		return nil
+	})
+}
@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gedcomSpec "github.com/jochenboesmans/gedcom-parser/gedcom"
+	"google.golang.org/protobuf/proto"
+)
+
+func testGedcom() *gedcomSpec.ConcurrencySafeGedcom {
+	g := gedcomSpec.NewConcurrencySafeGedcom()
+	g.Header = &gedcomSpec.Gedcom_HeaderType{
+		Source:         "gedcom-parser",
+		Submitter:      "@U1@",
+		CharacterSet:   "UTF-8",
+		GedcomMetaData: &gedcomSpec.Gedcom_HeaderType_GedcomMetaDataType{VersionNumber: "5.5.1", GedcomForm: "LINEAGE-LINKED"},
+	}
+	g.Individuals = []*gedcomSpec.Gedcom_Individual{
+		{
+			Id:     "@I1@",
+			Gender: "MALE",
+			Names:  []*gedcomSpec.Gedcom_Individual_Name{{GivenName: "John", Surname: "Smith", Primary: true}, {GivenName: "Johnny"}},
+			BirthEvents: []*gedcomSpec.Gedcom_Individual_Event{{
+				Date:     &gedcomSpec.Gedcom_Individual_Date{Year: "1900", Month: "01", Day: "1", Phrase: "New Year's Day"},
+				SortDate: &gedcomSpec.Gedcom_Individual_Date{Year: "1900"},
+				Place:    "London",
+			}},
+			DeathEvents:         []*gedcomSpec.Gedcom_Individual_Event{{Place: "London", Restriction: "privacy"}},
+			SpouseToFamilyLinks: []*gedcomSpec.Gedcom_Individual_FamilyLink{{FamilyId: "@F1@"}},
+			Associations: []*gedcomSpec.Gedcom_Individual_Association{{
+				IndividualId:    "@I2@",
+				SourceCitations: []*gedcomSpec.Gedcom_SourceCitation{{SourceId: "@S1@", Page: "12"}},
+			}},
+		},
+		{
+			Id:                 "@I2@",
+			ChildToFamilyLinks: []*gedcomSpec.Gedcom_Individual_FamilyLink{{FamilyId: "@F1@", Pedigree: "adopted"}},
+		},
+	}
+	g.Families = []*gedcomSpec.Gedcom_Family{
+		{
+			Id:              "@F1@",
+			FatherId:        "@I1@",
+			ChildIds:        []string{"@I2@"},
+			MarriageEvents:  []*gedcomSpec.Gedcom_Individual_Event{{Date: &gedcomSpec.Gedcom_Individual_Date{Year: "1925"}}},
+			SourceCitations: []*gedcomSpec.Gedcom_SourceCitation{{Description: "Family bible", Quality: "3"}},
+		},
+	}
+	g.Sources = []*gedcomSpec.Gedcom_Source{{Id: "@S1@", Title: "Parish register", AutomatedRecordId: "42"}}
+	g.Notes = []*gedcomSpec.Gedcom_Note{{Id: "@N1@", SubmitterText: "A note"}}
+	g.Repositories = []*gedcomSpec.Gedcom_Repository{{Id: "@R1@", Name: "Parish archive"}}
+	g.Multimedias = []*gedcomSpec.Gedcom_Multimedia{{Id: "@M1@", Files: []*gedcomSpec.Gedcom_Multimedia_File{{Reference: "photo.jpg", Format: "jpg"}}}}
+	g.Submitters = []*gedcomSpec.Gedcom_Submitter{{Id: "@U1@", Name: "Submitter"}}
+	return g
+}
+
+func TestWriteAndRead(t *testing.T) {
+	directory, err := ioutil.TempDir("", "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	databaseFilePath := filepath.Join(directory, "tree.sqlite")
+
+	g := testGedcom()
+	// writing twice replaces the database rather than failing on existing tables
+	for i := 0; i < 2; i++ {
+		if err := Write(g, databaseFilePath, nil); err != nil {
+			t.Fatalf("failed to write database with error: %s", err)
+		}
+	}
+
+	db, err := sql.Open(driverName, databaseFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var place string
+	var citations int
+	err = db.QueryRow(`SELECT places.name FROM events JOIN places ON places.id = events.place_id WHERE events.type = 'BIRT'`).Scan(&place)
+	if err != nil || place != "London" {
+		t.Errorf("expected birth place London, found %q with error: %v", place, err)
+	}
+	err = db.QueryRow(`SELECT COUNT(*) FROM citations WHERE source_id = '@S1@' AND page = '12'`).Scan(&citations)
+	if err != nil || citations != 1 {
+		t.Errorf("expected 1 citation of @S1@, found %d with error: %v", citations, err)
+	}
+
+	result, err := Read(databaseFilePath)
+	if err != nil {
+		t.Fatalf("failed to read database with error: %s", err)
+	}
+	expected := proto.Clone(&g.Gedcom).(*gedcomSpec.Gedcom)
+	// citations are read back as citations of the individuals themselves, without the associations holding them
+	expected.Individuals[0].SourceCitations = expected.Individuals[0].Associations[0].SourceCitations
+	expected.Individuals[0].Associations = nil
+	if !proto.Equal(&result.Gedcom, expected) {
+		t.Errorf("expected %v, found %v", expected, &result.Gedcom)
+	}
+
+	serialized, err := result.ToSerializedGedcom(nil)
+	if err != nil {
+		t.Fatalf("failed to serialize read gedcom with error: %s", err)
+	}
+	for _, line := range []string{"1 SUBM @U1@", "2 VERS 5.5.1", "0 @U1@ SUBM"} {
+		if !strings.Contains(serialized.String(), line+"\n") {
+			t.Errorf("expected GEDCOM of the read gedcom to hold %s, found:\n%s", line, serialized)
+		}
+	}
+}
+
+func TestReadMissingDatabase(t *testing.T) {
+	if _, err := Read(filepath.Join(os.TempDir(), "missing.sqlite")); err == nil {
+		t.Errorf("expected an error reading a database that doesn't exist")
+	}
+}
@@ -0,0 +1,38 @@
+package util
+
+// LDS ordinance status values per ordinance tag, as enumerated by GEDCOM 5.5.1
+var LDSStatusesByOrdinance = map[string]map[string]bool{
+	"BAPL": ldsBaptismStatuses,
+	"CONL": ldsBaptismStatuses,
+	"ENDL": toSet("CHILD", "COMPLETED", "EXCLUDED", "INFANT", "PRE-1970", "STILLBORN", "SUBMITTED", "UNCLEARED"),
+	"SLGC": toSet("BIC", "COMPLETED", "EXCLUDED", "DNS", "PRE-1970", "STILLBORN", "SUBMITTED", "UNCLEARED"),
+	"SLGS": toSet("CANCELED", "COMPLETED", "DNS", "DNS/CAN", "EXCLUDED", "PRE-1970", "SUBMITTED", "UNCLEARED"),
+}
+
+var ldsBaptismStatuses = toSet("CHILD", "COMPLETED", "EXCLUDED", "PRE-1970", "STILLBORN", "SUBMITTED", "UNCLEARED")
+
+// LDS temple code abbreviations as listed for GEDCOM 5.5.1
+var LDSTempleCodes = toSet(
+	"ABA", "ACCRA", "ADELA", "ALBER", "ALBUQ", "ANCHO", "APIA", "ARIZO", "ASUNC", "ATLAN",
+	"BAIRE", "BILLI", "BIRMI", "BISMA", "BOGOT", "BOISE", "BOSTO", "BOUNT", "BRISB", "BROUG",
+	"CAMPI", "CARAC", "CHICA", "CIUJU", "COCHA", "COLJU", "COLSC", "COLUM", "COPEN", "CRIVE",
+	"DALLA", "DENVE", "DETRO", "EDMON", "EHOUS", "FORTL", "FRANK", "FREIB", "FRESN", "FUKUO",
+	"GUADA", "GUATE", "GUAYA", "HAGUE", "HALIF", "HARTF", "HAWAI", "HELSI", "HERMO", "HKONG",
+	"HOUST", "IFALL", "JOHAN", "JRIVE", "KIEV", "KONA", "LANGE", "LIMA", "LOGAN", "LONDO",
+	"LOUIS", "LUBBO", "LVEGA", "MADRI", "MANIL", "MANTI", "MEDFO", "MELBO", "MEMPH", "MERID",
+	"MEXIC", "MNTVD", "MONTE", "MONTI", "MONTR", "MTIMP", "NASHV", "NBEAC", "NUKUA", "NYORK",
+	"NZEAL", "OAKLA", "OAXAC", "OGDEN", "OKLAH", "ORLAN", "PALEG", "PALMY", "PAPEE", "PERTH",
+	"PHOEN", "POCAT", "PORTL", "PREST", "PROVO", "QUETZ", "RALEI", "RECIF", "REDLA", "REGIN",
+	"RENO", "SACRA", "SANTI", "SAOPA", "SDIEG", "SDOMI", "SEATT", "SEOUL", "SGEOR", "SJOSE",
+	"SLAKE", "SLOUI", "SNOWF", "SPAUL", "SPMIN", "SPOKA", "STOCK", "SUVA", "SWISS", "SYDNE",
+	"TAIPE", "TAMPI", "TEGUC", "TGUTI", "TIJUA", "TOKYO", "TORNO", "TRUJI", "TWINF", "VANCO",
+	"VERAC", "VERNA", "VILLA", "WASHI", "WINTE",
+)
+
+func toSet(values ...string) map[string]bool {
+	r := map[string]bool{}
+	for _, v := range values {
+		r[v] = true
+	}
+	return r
+}
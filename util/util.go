@@ -15,7 +15,7 @@ var MonthAbbrByInt = map[string]string{
 	"12": "DEC",
 }
 
-var MonthIntByAbbr = invertStringStringMap(MonthAbbrByInt)
+var MonthIntByAbbr = InvertStringStringMap(MonthAbbrByInt)
 
 var PrimaryValueByBool = map[bool]string{
 	true:  "Y",
@@ -29,7 +29,7 @@ var GenderLetterByFull = map[string]string{
 	"FEMALE": "F",
 }
 
-var GenderFullByLetter = invertStringStringMap(GenderLetterByFull)
+var GenderFullByLetter = InvertStringStringMap(GenderLetterByFull)
 
 func invertBoolStringMap(m map[bool]string) map[string]bool {
 	r := map[string]bool{}
@@ -38,7 +38,9 @@ func invertBoolStringMap(m map[bool]string) map[string]bool {
 	}
 	return r
 }
-func invertStringStringMap(m map[string]string) map[string]string {
+
+// InvertStringStringMap returns a map from the values of a map to its keys
+func InvertStringStringMap(m map[string]string) map[string]string {
 	r := map[string]string{}
 	for k, v := range m {
 		r[v] = k